@@ -0,0 +1,61 @@
+package spanner_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/sqltest"
+)
+
+func TestUsers(t *testing.T) {
+	sdb, cleanup := sqltest.Spanner(t, []string{"../schema.sql"})
+	defer cleanup()
+
+	ctx := context.Background()
+	db := New(sdb)
+
+	// create a user
+	if err := db.CreateUser(ctx, CreateUserParams{
+		ID:    "1",
+		Name:  sql.NullString{String: "Rob Pike", Valid: true},
+		Email: sql.NullString{String: "rob@example.com", Valid: true},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// get the user we just inserted
+	user, err := db.GetUser(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Name.String != "Rob Pike" {
+		t.Errorf("expected name Rob Pike, got %v", user.Name)
+	}
+
+	// update the user
+	if err := db.UpdateUser(ctx, UpdateUserParams{
+		ID:    "1",
+		Name:  sql.NullString{String: "Robert Pike", Valid: true},
+		Email: user.Email,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// list all users
+	users, err := db.ListUsers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].Name.String != "Robert Pike" {
+		t.Errorf("unexpected users: %v", users)
+	}
+
+	// delete the user
+	if err := db.DeleteUser(ctx, "1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetUser(ctx, "1"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
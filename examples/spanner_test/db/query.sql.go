@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.29.0
+//   sqlc v1.30.0
 // source: query.sql
 
 package spanner_test
@@ -21,7 +21,7 @@ type CreateUserParams struct {
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
-	_, err := q.db.ExecContext(ctx, createUser, arg.ID, arg.Name, arg.Email)
+	_, err := q.db.ExecContext(ctx, createUser, sql.Named("id", arg.ID), sql.Named("name", arg.Name), sql.Named("email", arg.Email))
 	return err
 }
 
@@ -36,7 +36,7 @@ type CreateUserReturningParams struct {
 }
 
 func (q *Queries) CreateUserReturning(ctx context.Context, arg CreateUserReturningParams) (User, error) {
-	row := q.db.QueryRowContext(ctx, createUserReturning, arg.ID, arg.Name, arg.Email)
+	row := q.db.QueryRowContext(ctx, createUserReturning, sql.Named("id", arg.ID), sql.Named("name", arg.Name), sql.Named("email", arg.Email))
 	var i User
 	err := row.Scan(&i.ID, &i.Name, &i.Email)
 	return i, err
@@ -47,7 +47,7 @@ DELETE FROM users WHERE id = @id;
 `
 
 func (q *Queries) DeleteUser(ctx context.Context, id string) error {
-	_, err := q.db.ExecContext(ctx, deleteUser, id)
+	_, err := q.db.ExecContext(ctx, deleteUser, sql.Named("id", id))
 	return err
 }
 
@@ -55,8 +55,8 @@ const getUser = `-- name: GetUser :one
 SELECT id, name, email FROM users WHERE id = @user_id;
 `
 
-func (q *Queries) GetUser(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUser, id)
+func (q *Queries) GetUser(ctx context.Context, userID string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUser, sql.Named("user_id", userID))
 	var i User
 	err := row.Scan(&i.ID, &i.Name, &i.Email)
 	return i, err
@@ -127,6 +127,6 @@ type UpdateUserParams struct {
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
-	_, err := q.db.ExecContext(ctx, updateUser, arg.Name, arg.Email, arg.ID)
+	_, err := q.db.ExecContext(ctx, updateUser, sql.Named("name", arg.Name), sql.Named("email", arg.Email), sql.Named("id", arg.ID))
 	return err
 }
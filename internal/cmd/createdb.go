@@ -71,6 +71,8 @@ func CreateDB(ctx context.Context, dir, filename, querySetName string, o *Option
 		// pass
 	case config.EnginePostgreSQL:
 		// pass
+	case config.EngineSpanner:
+		// pass
 	default:
 		return fmt.Errorf("createdb does not support the %s engine", queryset.Engine)
 	}
@@ -92,6 +94,7 @@ func CreateDB(ctx context.Context, dir, filename, querySetName string, o *Option
 	client := dbmanager.NewClient(conf.Servers)
 	resp, err := client.CreateDatabase(ctx, &dbmanager.CreateDatabaseRequest{
 		Engine:     string(queryset.Engine),
+		Dialect:    string(queryset.Dialect),
 		Migrations: ddl,
 		Prefix:     fmt.Sprintf("sqlc_createdb_%d", now),
 	})
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/trace"
+
+	"github.com/spf13/cobra"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/engine/spanner"
+	"github.com/sqlc-dev/sqlc/internal/migrations"
+	"github.com/sqlc-dev/sqlc/internal/multierr"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Inspect and migrate database schemas",
+}
+
+var schemaDiffCmd = &cobra.Command{
+	Use:   "diff <desired schema>",
+	Short: "Print the DDL that migrates the configured schema to a desired schema",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer trace.StartRegion(cmd.Context(), "schema diff").End()
+		stderr := cmd.ErrOrStderr()
+		dir, filename := getConfigPath(stderr, cmd.Flag("file"))
+		querySetName, err := cmd.Flags().GetString("queryset")
+		if err != nil {
+			return err
+		}
+		err = SchemaDiff(cmd.Context(), dir, filename, querySetName, args[0], cmd.OutOrStdout(), &Options{
+			Env:    ParseEnv(cmd),
+			Stderr: stderr,
+		})
+		if err != nil {
+			if fileErrs, ok := err.(*multierr.Error); ok {
+				for _, fileErr := range fileErrs.Errs() {
+					printFileErr(stderr, dir, fileErr)
+				}
+			} else {
+				fmt.Fprintln(stderr, err.Error())
+			}
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+// SchemaDiff compares the schema built from a queryset's migrations with the
+// desired schema file and writes the DDL statements that migrate one to the
+// other. Changes that can't be migrated are returned as a *multierr.Error
+// pointing into the desired schema file.
+func SchemaDiff(ctx context.Context, dir, filename, querySetName, desiredPath string, stdout io.Writer, o *Options) error {
+	_, conf, err := o.ReadConfig(dir, filename)
+	if err != nil {
+		return err
+	}
+	var queryset *config.SQL
+	var count int
+	for _, sql := range conf.SQL {
+		sql := sql
+		if querySetName != "" && sql.Name != querySetName {
+			continue
+		}
+		if sql.Engine == config.EngineSpanner {
+			queryset = &sql
+			count += 1
+		}
+	}
+	if queryset == nil && querySetName != "" {
+		return fmt.Errorf("no Spanner queryset found with name %q", querySetName)
+	}
+	if queryset == nil {
+		return fmt.Errorf("schema diff only supports the spanner engine; no Spanner querysets configured")
+	}
+	if count > 1 {
+		return fmt.Errorf("multiple Spanner querysets configured; choose one with --queryset")
+	}
+	if queryset.Dialect == config.DialectPostgreSQL {
+		return fmt.Errorf("schema diff does not support the PostgreSQL dialect of Spanner")
+	}
+
+	paths := make([]string, 0, len(queryset.Schema))
+	for _, s := range queryset.Schema {
+		paths = append(paths, filepath.Join(dir, s))
+	}
+	files, err := sqlpath.Glob(paths)
+	if err != nil {
+		return err
+	}
+
+	merr := multierr.New()
+	current := spanner.NewSchema()
+	for _, filename := range files {
+		blob, err := os.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("read file: %w", err)
+		}
+		contents := migrations.RemoveRollbackStatements(string(blob))
+		if err := current.Apply(contents); err != nil {
+			merr.Add(filename, contents, 0, err)
+			return merr
+		}
+	}
+
+	blob, err := os.ReadFile(desiredPath)
+	if err != nil {
+		return fmt.Errorf("read file: %w", err)
+	}
+	contents := string(blob)
+	desired := spanner.NewSchema()
+	if err := desired.Apply(contents); err != nil {
+		merr.Add(desiredPath, contents, 0, err)
+		return merr
+	}
+
+	stmts, errs := spanner.DiffSchema(current, desired)
+	for _, stmt := range stmts {
+		fmt.Fprintf(stdout, "%s;\n", stmt.SQL())
+	}
+	for _, err := range errs {
+		merr.Add(desiredPath, contents, 0, err)
+	}
+	if len(merr.Errs()) > 0 {
+		return merr
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ func pluginSettings(r *compiler.Result, cs config.CombinedSettings) *plugin.Sett
 	return &plugin.Settings{
 		Version: cs.Global.Version,
 		Engine:  string(cs.Package.Engine),
+		Dialect: string(cs.Package.Dialect),
 		Schema:  []string(cs.Package.Schema),
 		Queries: []string(cs.Package.Queries),
 		Codegen: pluginCodegen(cs, cs.Codegen),
@@ -105,6 +106,7 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 						Schema:  t.Rel.Schema,
 						Name:    t.Rel.Name,
 					},
+					AllowCommitTimestamp: c.AllowCommitTimestamp,
 				})
 			}
 			tables = append(tables, &plugin.Table{
@@ -153,14 +155,18 @@ func pluginQueries(r *compiler.Result) []*plugin.Query {
 			}
 		}
 		out = append(out, &plugin.Query{
-			Name:            q.Metadata.Name,
-			Cmd:             q.Metadata.Cmd,
-			Text:            q.SQL,
-			Comments:        q.Metadata.Comments,
-			Columns:         columns,
-			Params:          params,
-			Filename:        q.Metadata.Filename,
-			InsertIntoTable: iit,
+			Name:             q.Metadata.Name,
+			Cmd:              q.Metadata.Cmd,
+			Text:             q.SQL,
+			Comments:         q.Metadata.Comments,
+			Columns:          columns,
+			Params:           params,
+			Filename:         q.Metadata.Filename,
+			InsertIntoTable:  iit,
+			ForUpdate:        q.ForUpdate,
+			Keyset:           q.Metadata.Keyset,
+			KeysetExprs:      q.KeysetExprs,
+			KeysetDescending: q.KeysetDescending,
 		})
 	}
 	return out
@@ -172,17 +178,20 @@ func pluginQueryColumn(c *compiler.Column) *plugin.Column {
 		l = *c.Length
 	}
 	out := &plugin.Column{
-		Name:         c.Name,
-		OriginalName: c.OriginalName,
-		Comment:      c.Comment,
-		NotNull:      c.NotNull,
-		Unsigned:     c.Unsigned,
-		IsArray:      c.IsArray,
-		ArrayDims:    int32(c.ArrayDims),
-		Length:       int32(l),
-		IsNamedParam: c.IsNamedParam,
-		IsFuncCall:   c.IsFuncCall,
-		IsSqlcSlice:  c.IsSqlcSlice,
+		Name:           c.Name,
+		OriginalName:   c.OriginalName,
+		Comment:        c.Comment,
+		NotNull:        c.NotNull,
+		Unsigned:       c.Unsigned,
+		IsArray:        c.IsArray,
+		ArrayDims:      int32(c.ArrayDims),
+		Length:         int32(l),
+		IsNamedParam:   c.IsNamedParam,
+		IsFuncCall:     c.IsFuncCall,
+		Scope:          c.Scope,
+		IsSqlcSlice:    c.IsSqlcSlice,
+		IsSqlcOptional: c.IsSqlcOptional,
+		OrderByKeys:    c.OrderByKeys,
 	}
 
 	if c.Type != nil {
@@ -424,6 +424,7 @@ func (c *checker) fetchDatabaseUri(ctx context.Context, s config.SQL) (string, f
 
 	resp, err := c.Client.CreateDatabase(ctx, &dbmanager.CreateDatabaseRequest{
 		Engine:     string(s.Engine),
+		Dialect:    string(s.Dialect),
 		Migrations: ddl,
 	})
 	if err != nil {
@@ -529,6 +530,17 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 			// SQLite really doesn't want us to depend on the output of EXPLAIN
 			// QUERY PLAN: https://www.sqlite.org/eqp.html
 			expl = nil
+		case config.EngineSpanner:
+			db, err := sql.Open("spanner", dburl)
+			if err != nil {
+				return fmt.Errorf("database: connection error: %s", err)
+			}
+			if err := db.PingContext(ctx); err != nil {
+				return fmt.Errorf("database: connection error: %s", err)
+			}
+			defer db.Close()
+			prep = &spannerPreparer{db}
+			expl = nil
 		default:
 			return fmt.Errorf("unsupported database uri: %s", s.Engine)
 		}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+
+	"cloud.google.com/go/spanner"
+	"cloud.google.com/go/spanner/apiv1/spannerpb"
+	spannerdriver "github.com/googleapis/go-sql-spanner"
+)
+
+// spannerPreparer checks queries by asking Spanner for their query plan, as
+// preparing a statement with go-sql-spanner doesn't contact the server
+type spannerPreparer struct {
+	db *sql.DB
+}
+
+func (p *spannerPreparer) Prepare(ctx context.Context, name, query string) error {
+	rows, err := p.db.QueryContext(ctx, query, spannerdriver.ExecOptions{
+		QueryOptions: spanner.QueryOptions{Mode: spannerpb.ExecuteSqlRequest_PLAN.Enum()},
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+	}
+	return rows.Err()
+}
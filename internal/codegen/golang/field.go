@@ -29,6 +29,14 @@ func (gf Field) HasSqlcSlice() bool {
 	return gf.Column.IsSqlcSlice
 }
 
+func (gf Field) HasSqlcOptional() bool {
+	return gf.Column.IsSqlcOptional
+}
+
+func (gf Field) HasSqlcOrderBy() bool {
+	return len(gf.Column.GetOrderByKeys()) > 0
+}
+
 func TagsToString(tags map[string]string) string {
 	if len(tags) == 0 {
 		return ""
@@ -25,6 +25,10 @@ type tmplCtx struct {
 	GoQueries   []Query
 	SqlcVersion string
 
+	SpannerMutations []SpannerMutation
+
+	EmitSpannerRunInTransaction bool
+
 	// TODO: Race conditions
 	SourceName string
 
@@ -39,6 +43,7 @@ type tmplCtx struct {
 	EmitAllEnumValues         bool
 	UsesCopyFrom              bool
 	UsesBatch                 bool
+	UsesChangeRecords         bool
 	OmitSqlcVersion           bool
 	BuildTags                 string
 	WrapErrors                bool
@@ -74,12 +79,16 @@ func (t *tmplCtx) codegenQueryMethod(q Query) string {
 		}
 		return db + ".QueryRowContext"
 
-	case ":many":
+	case ":many", ":iter":
 		if t.EmitPreparedQueries {
 			return "q.query"
 		}
 		return db + ".QueryContext"
 
+	case ":partitioned":
+		// A prepared statement can't be partitioned
+		return db + ".QueryRowContext"
+
 	default:
 		if t.EmitPreparedQueries {
 			return "q.exec"
@@ -92,7 +101,7 @@ func (t *tmplCtx) codegenQueryRetval(q Query) (string, error) {
 	switch q.Cmd {
 	case ":one":
 		return "row :=", nil
-	case ":many":
+	case ":many", ":iter":
 		return "rows, err :=", nil
 	case ":exec":
 		return "_, err :=", nil
@@ -114,7 +123,7 @@ func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.Generat
 		return nil, err
 	}
 
-	if err := opts.ValidateOpts(options); err != nil {
+	if err := opts.ValidateOpts(options, req.Settings.Engine); err != nil {
 		return nil, err
 	}
 
@@ -183,6 +192,7 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		EmitAllEnumValues:         options.EmitAllEnumValues,
 		UsesCopyFrom:              usesCopyFrom(queries),
 		UsesBatch:                 usesBatch(queries),
+		UsesChangeRecords:         usesChangeRecords(queries),
 		SQLDriver:                 parseDriver(options.SqlPackage),
 		Q:                         "`",
 		Package:                   options.Package,
@@ -192,6 +202,12 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		BuildTags:                 options.BuildTags,
 		OmitSqlcVersion:           options.OmitSqlcVersion,
 		WrapErrors:                options.WrapErrors,
+
+		EmitSpannerRunInTransaction: options.EmitSpannerRunInTransaction,
+	}
+
+	if options.EmitSpannerMutations {
+		tctx.SpannerMutations = buildSpannerMutations(req, structs)
 	}
 
 	if tctx.UsesCopyFrom && !tctx.SQLDriver.IsPGX() && options.SqlDriver != opts.SQLDriverGoSQLDriverMySQL {
@@ -205,6 +221,14 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		tctx.SQLDriver = opts.SQLDriverGoSQLDriverMySQL
 	}
 
+	if usesPartitioned(queries) && tctx.SQLDriver.IsPGX() {
+		return nil, errors.New(":partitioned is only supported by database/sql")
+	}
+
+	if tctx.EmitSpannerRunInTransaction && tctx.SQLDriver.IsPGX() {
+		return nil, errors.New("emit_spanner_run_in_transaction is only supported by database/sql")
+	}
+
 	if tctx.UsesBatch && !tctx.SQLDriver.IsPGX() {
 		return nil, errors.New(":batch* commands are only supported by pgx")
 	}
@@ -289,6 +313,11 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		batchFileName = options.OutputBatchFileName
 	}
 
+	mutationsFileName := "mutations.go"
+	if options.OutputMutationsFileName != "" {
+		mutationsFileName = options.OutputMutationsFileName
+	}
+
 	if err := execute(dbFileName, "dbFile"); err != nil {
 		return nil, err
 	}
@@ -310,6 +339,11 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 			return nil, err
 		}
 	}
+	if len(tctx.SpannerMutations) > 0 {
+		if err := execute(mutationsFileName, "mutationsFile"); err != nil {
+			return nil, err
+		}
+	}
 
 	files := map[string]struct{}{}
 	for _, gq := range queries {
@@ -353,6 +387,33 @@ func usesBatch(queries []Query) bool {
 	return false
 }
 
+func usesIter(queries []Query) bool {
+	for _, q := range queries {
+		if q.Cmd == metadata.CmdIter {
+			return true
+		}
+	}
+	return false
+}
+
+func usesPartitioned(queries []Query) bool {
+	for _, q := range queries {
+		if q.Cmd == metadata.CmdPartitioned {
+			return true
+		}
+	}
+	return false
+}
+
+func usesChangeRecords(queries []Query) bool {
+	for _, q := range queries {
+		if q.DecodesChangeRecords() {
+			return true
+		}
+	}
+	return false
+}
+
 func checkNoTimesForMySQLCopyFrom(queries []Query) error {
 	for _, q := range queries {
 		if q.Cmd != metadata.CmdCopyFrom {
@@ -378,6 +439,11 @@ func filterUnusedStructs(enums []Enum, structs []Struct, queries []Query) ([]Enu
 					keepTypes[field.Type] = struct{}{}
 				}
 			}
+			for _, s := range query.ParamStructs {
+				for _, field := range s.Fields {
+					keepTypes[field.Type] = struct{}{}
+				}
+			}
 		}
 		if query.hasRetType() {
 			keepTypes[query.Ret.Type()] = struct{}{}
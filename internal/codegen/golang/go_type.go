@@ -3,6 +3,8 @@ package golang
 import (
 	"strings"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
 	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
 	"github.com/sqlc-dev/sqlc/internal/plugin"
@@ -40,6 +42,13 @@ func addExtraGoStructTags(tags map[string]string, req *plugin.GenerateRequest, o
 }
 
 func goType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) string {
+	if col.IsSqlcOptional {
+		// A sqlc.optional() parameter is nil when its predicate is dropped
+		c := proto.Clone(col).(*plugin.Column)
+		c.NotNull = true
+		c.IsSqlcOptional = false
+		return "*" + goType(req, options, c)
+	}
 	// Check if the column's type has been overridden
 	for _, override := range options.Overrides {
 		oride := override.ShimOverride
@@ -90,6 +99,9 @@ func goInnerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin
 	case "sqlite":
 		return sqliteType(req, options, col)
 	case "spanner":
+		if req.Settings.Dialect == "postgresql" {
+			return spannerPostgresType(req, options, col)
+		}
 		return spannerType(req, options, col)
 	default:
 		return "interface{}"
@@ -101,6 +101,10 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 	if i.Options.OutputBatchFileName != "" {
 		batchFileName = i.Options.OutputBatchFileName
 	}
+	mutationsFileName := "mutations.go"
+	if i.Options.OutputMutationsFileName != "" {
+		mutationsFileName = i.Options.OutputMutationsFileName
+	}
 
 	switch filename {
 	case dbFileName:
@@ -113,6 +117,8 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 		return mergeImports(i.copyfromImports())
 	case batchFileName:
 		return mergeImports(i.batchImports())
+	case mutationsFileName:
+		return mergeImports(i.mutationsImports())
 	default:
 		return mergeImports(i.queryImports(filename))
 	}
@@ -139,6 +145,11 @@ func (i *importer) dbImports() fileImports {
 		}
 	}
 
+	if i.Options.EmitSpannerRunInTransaction {
+		std = append(std, ImportSpec{Path: "errors"})
+		pkg = append(pkg, ImportSpec{ID: "spannerdriver", Path: "github.com/googleapis/go-sql-spanner"})
+	}
+
 	sort.Slice(std, func(i, j int) bool { return std[i].Path < std[j].Path })
 	sort.Slice(pkg, func(i, j int) bool { return pkg[i].Path < pkg[j].Path })
 	return fileImports{Std: std, Dep: pkg}
@@ -151,6 +162,7 @@ var stdlibTypes = map[string]string{
 	"net.HardwareAddr": "net",
 	"netip.Addr":       "net/netip",
 	"netip.Prefix":     "net/netip",
+	"big.Rat":          "math/big",
 }
 
 var pqtypeTypes = map[string]struct{}{
@@ -160,6 +172,17 @@ var pqtypeTypes = map[string]struct{}{
 	"pqtype.NullRawMessage": {},
 }
 
+var spannerTypes = map[string]struct{}{
+	"spanner.Interval":     {},
+	"spanner.NullInterval": {},
+	"spanner.NullDate":     {},
+	"spanner.NullNumeric":  {},
+	"spanner.NullJSON":     {},
+	"spanner.NullFloat32":  {},
+	"spanner.PGNumeric":    {},
+	"spanner.PGJsonB":      {},
+}
+
 func buildImports(options *opts.Options, queries []Query, uses func(string) bool) (map[string]struct{}, map[ImportSpec]struct{}) {
 	pkg := make(map[ImportSpec]struct{})
 	std := make(map[string]struct{})
@@ -228,13 +251,18 @@ func buildImports(options *opts.Options, queries []Query, uses func(string) bool
 	if uses("pgvector.Vector") && !overrideVector {
 		pkg[ImportSpec{Path: "github.com/pgvector/pgvector-go"}] = struct{}{}
 	}
-	_, overrideInterval := overrideTypes["spanner.Interval"]
-	if uses("spanner.Interval") && !overrideInterval {
-		pkg[ImportSpec{Path: "cloud.google.com/go/spanner"}] = struct{}{}
+	_, overrideCivilDate := overrideTypes["civil.Date"]
+	if uses("civil.Date") && !overrideCivilDate {
+		pkg[ImportSpec{Path: "cloud.google.com/go/civil"}] = struct{}{}
 	}
-	_, overrideNullInterval := overrideTypes["spanner.NullInterval"]
-	if uses("spanner.NullInterval") && !overrideNullInterval {
-		pkg[ImportSpec{Path: "cloud.google.com/go/spanner"}] = struct{}{}
+	for typeName := range spannerTypes {
+		if _, ok := overrideTypes[typeName]; ok {
+			continue
+		}
+		if uses(typeName) {
+			pkg[ImportSpec{Path: "cloud.google.com/go/spanner"}] = struct{}{}
+			break
+		}
 	}
 
 	// Custom imports
@@ -275,6 +303,12 @@ func (i *importer) interfaceImports() fileImports {
 	})
 
 	std["context"] = struct{}{}
+	if usesIter(i.Queries) {
+		std["iter"] = struct{}{}
+	}
+	if usesPartitioned(i.Queries) {
+		pkg[ImportSpec{ID: "spannerdriver", Path: "github.com/googleapis/go-sql-spanner"}] = struct{}{}
+	}
 
 	return sortedImports(std, pkg)
 }
@@ -287,6 +321,12 @@ func (i *importer) modelImports() fileImports {
 		std["database/sql/driver"] = struct{}{}
 	}
 
+	if usesChangeRecords(i.Queries) {
+		std["fmt"] = struct{}{}
+		std["time"] = struct{}{}
+		pkg[ImportSpec{Path: "cloud.google.com/go/spanner"}] = struct{}{}
+	}
+
 	return sortedImports(std, pkg)
 }
 
@@ -333,6 +373,14 @@ func (i *importer) queryImports(filename string) fileImports {
 					return true
 				}
 			}
+			// Check the fields of STRUCT parameter types
+			for _, s := range q.ParamStructs {
+				for _, f := range s.Fields {
+					if hasPrefixIgnoringSliceAndPointerPrefix(f.Type, name) {
+						return true
+					}
+				}
+			}
 			// Check the fields of the argument struct if it's emitted
 			if q.Arg.EmitStruct() {
 				for _, f := range q.Arg.Struct.Fields {
@@ -353,6 +401,9 @@ func (i *importer) queryImports(filename string) fileImports {
 
 	sliceScan := func() bool {
 		for _, q := range gq {
+			if q.Engine == "spanner" {
+				continue
+			}
 			if q.hasRetType() {
 				if q.Ret.IsStruct() {
 					for _, f := range q.Ret.Struct.Fields {
@@ -371,7 +422,7 @@ func (i *importer) queryImports(filename string) fileImports {
 					}
 				}
 			}
-			if !q.Arg.isEmpty() {
+			if !q.Arg.isEmpty() && !q.Arg.usesNamedArgs() {
 				if q.Arg.IsStruct() {
 					for _, f := range q.Arg.Struct.Fields {
 						if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && !f.HasSqlcSlice() {
@@ -402,10 +453,51 @@ func (i *importer) queryImports(filename string) fileImports {
 		std["context"] = struct{}{}
 	}
 
+	for _, q := range gq {
+		if !q.Arg.isEmpty() && q.Arg.usesNamedArgs() {
+			std["database/sql"] = struct{}{}
+			break
+		}
+	}
+
+	if usesIter(gq) {
+		std["iter"] = struct{}{}
+	}
+
+	if usesChangeRecords(gq) {
+		pkg[ImportSpec{ID: "spannerdriver", Path: "github.com/googleapis/go-sql-spanner"}] = struct{}{}
+	}
+
+	if usesPartitioned(gq) {
+		std["database/sql"] = struct{}{}
+		pkg[ImportSpec{Path: "cloud.google.com/go/spanner"}] = struct{}{}
+		pkg[ImportSpec{ID: "spannerdriver", Path: "github.com/googleapis/go-sql-spanner"}] = struct{}{}
+	}
+
 	sqlpkg := parseDriver(i.Options.SqlPackage)
 	if sqlcSliceScan() && !sqlpkg.IsPGX() {
 		std["strings"] = struct{}{}
 	}
+	for _, q := range gq {
+		if q.Arg.HasSqlcOptionals() || q.Arg.HasSqlcOrderBys() {
+			std["strings"] = struct{}{}
+		}
+		if q.renumbersOptionals() {
+			std["strconv"] = struct{}{}
+		}
+		if q.Keyset != nil {
+			std["encoding/base64"] = struct{}{}
+			std["encoding/json"] = struct{}{}
+			std["fmt"] = struct{}{}
+			std["strings"] = struct{}{}
+			if q.Keyset.numbered {
+				std["strconv"] = struct{}{}
+			}
+			if q.Keyset.named {
+				std["database/sql"] = struct{}{}
+			}
+		}
+	}
 	if sliceScan() && !sqlpkg.IsPGX() {
 		pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
 	}
@@ -532,3 +624,10 @@ func replaceConflictedArg(imports [][]ImportSpec, queries []Query) []Query {
 	}
 	return replacedQueries
 }
+
+func (i *importer) mutationsImports() fileImports {
+	pkg := map[ImportSpec]struct{}{
+		{Path: "cloud.google.com/go/spanner"}: {},
+	}
+	return sortedImports(map[string]struct{}{}, pkg)
+}
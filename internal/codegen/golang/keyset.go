@@ -0,0 +1,233 @@
+package golang
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// Keyset is the cursor of a query paginated with @paginate keyset(...)
+type Keyset struct {
+	Cursor Struct
+
+	// The fields of the last row of a page that the next cursor is made of
+	values []string
+
+	exprs      []string
+	descending bool
+	// How the cursor values and the limit are bound: to named parameters,
+	// to numbered placeholders or to ? placeholders
+	named    bool
+	numbered bool
+	// Spanner can only compare STRUCT values for equality
+	expanded bool
+}
+
+// ParseFunc is the name of the function that decodes a cursor
+func (k Keyset) ParseFunc() string {
+	return "Parse" + k.Cursor.Name
+}
+
+// NextCursor returns the cursor of the page after the rows read into items
+func (k Keyset) NextCursor() string {
+	var fields []string
+	for i, f := range k.Cursor.Fields {
+		fields = append(fields, f.Name+": "+k.values[i])
+	}
+	return "&" + k.Cursor.Name + "{" + strings.Join(fields, ", ") + "}"
+}
+
+func buildKeyset(req *plugin.GenerateRequest, options *opts.Options, q *Query, query *plugin.Query) (*Keyset, error) {
+	sqlpkg := parseDriver(options.SqlPackage)
+	engine := req.Settings.Engine
+	// The PostgreSQL interface of Spanner numbers its placeholders
+	pgDialect := engine == "spanner" && req.Settings.Dialect == "postgresql"
+	k := &Keyset{
+		Cursor: Struct{
+			Name:    q.MethodName + "Cursor",
+			Comment: fmt.Sprintf("%sCursor is the position after which a page of %s starts", q.MethodName, q.MethodName),
+		},
+		exprs:      query.KeysetExprs,
+		descending: query.KeysetDescending,
+		named:      engine == "spanner" && !pgDialect && !sqlpkg.IsPGX(),
+		numbered:   engine == "postgresql" || pgDialect || (engine == "spanner" && sqlpkg.IsPGX()),
+		expanded:   engine == "spanner",
+	}
+	for _, name := range query.Keyset {
+		f, value := keysetField(options, q.Ret, query.Columns, name)
+		if f == nil {
+			return nil, fmt.Errorf("query %s: keyset column %q isn't a field of its result", q.MethodName, name)
+		}
+		k.Cursor.Fields = append(k.Cursor.Fields, Field{
+			Name: f.Name,
+			Type: f.Type,
+			Tags: map[string]string{"json": name},
+		})
+		k.values = append(k.values, value)
+	}
+	return k, nil
+}
+
+// keysetField returns the field of the result that holds the column name, and
+// its value in the last row of a page
+func keysetField(options *opts.Options, ret QueryValue, cols []*plugin.Column, name string) (*Field, string) {
+	last := "items[len(items)-1]"
+	if ret.Struct == nil {
+		if !strings.EqualFold(ret.DBName, name) {
+			return nil, ""
+		}
+		return &Field{Name: StructName(ret.DBName, options), Type: ret.Type()}, last
+	}
+	for i, f := range ret.Struct.Fields {
+		dbName := f.DBName
+		// The fields of a model struct are in the order of the columns
+		if dbName == "" && len(ret.Struct.Fields) == len(cols) {
+			dbName = cols[i].Name
+		}
+		if len(f.EmbedFields) == 0 && strings.EqualFold(dbName, name) {
+			return &f, last + "." + f.Name
+		}
+	}
+	return nil, ""
+}
+
+// KeysetPair returns the parameters of a paginated query method, which take
+// the cursor and the size of the page after the arguments of the query
+func (q Query) KeysetPair() string {
+	pair := fmt.Sprintf("cursor *%s, limit int32", q.Keyset.Cursor.Name)
+	if args := q.Arg.Pair(); args != "" {
+		return args + ", " + pair
+	}
+	return pair
+}
+
+// BindKeyset returns the code that adds the predicate of the cursor, when
+// there is one, and the LIMIT to a paginated query. The markers are written by
+// internal/sql/rewrite.KeysetPagination.
+func (q Query) BindKeyset() string {
+	k := q.Keyset
+	marker, keyword := "/*KEYSET:AND*/", " AND "
+	if strings.Contains(q.SQL, "/*KEYSET:WHERE*/") {
+		marker, keyword = "/*KEYSET:WHERE*/", " WHERE "
+	}
+
+	var values []string
+	for i, f := range k.Cursor.Fields {
+		values = append(values, k.bind(fmt.Sprintf("sqlc_cursor_%d", i), "cursor."+f.Name))
+	}
+	pred := &concat{}
+	pred.text(keyword)
+	k.predicate(pred)
+
+	limit := &concat{}
+	limit.text(" LIMIT ")
+	k.placeholder(limit, "sqlc_limit", 0)
+
+	var b strings.Builder
+	b.WriteString("if cursor != nil {\n")
+	fmt.Fprintf(&b, "queryParams = append(queryParams, %s)\n", strings.Join(values, ", "))
+	fmt.Fprintf(&b, "query = strings.Replace(query, %q, %s, 1)\n", marker, pred)
+	b.WriteString("}\n")
+	if k.named {
+		fmt.Fprintf(&b, "queryParams = append(queryParams, %s)\n", k.bind("sqlc_limit", "int64(limit)"))
+	} else {
+		b.WriteString("queryParams = append(queryParams, limit)\n")
+	}
+	fmt.Fprintf(&b, "query = strings.Replace(query, %q, %s, 1)", "/*LIMIT*/", limit)
+	return b.String()
+}
+
+func (k *Keyset) bind(name, value string) string {
+	if k.named {
+		return fmt.Sprintf("sql.Named(%q, %s)", name, value)
+	}
+	return value
+}
+
+// placeholder writes the placeholder of the parameter name, which is bound
+// back values before the last one
+func (k *Keyset) placeholder(c *concat, name string, back int) {
+	switch {
+	case k.named:
+		c.text("@" + name)
+	case k.numbered:
+		c.text("$")
+		if back == 0 {
+			c.code("strconv.Itoa(len(queryParams))")
+		} else {
+			c.code(fmt.Sprintf("strconv.Itoa(len(queryParams)-%d)", back))
+		}
+	default:
+		c.text("?")
+	}
+}
+
+// predicate writes the condition that matches the rows after the cursor
+func (k *Keyset) predicate(c *concat) {
+	op := " > "
+	if k.descending {
+		op = " < "
+	}
+	n := len(k.exprs)
+	param := func(i int) {
+		k.placeholder(c, fmt.Sprintf("sqlc_cursor_%d", i), n-1-i)
+	}
+	if n == 1 {
+		c.text(k.exprs[0] + op)
+		param(0)
+		return
+	}
+	if !k.expanded {
+		c.text("(" + strings.Join(k.exprs, ", ") + ")" + op + "(")
+		for i := range k.exprs {
+			if i > 0 {
+				c.text(", ")
+			}
+			param(i)
+		}
+		c.text(")")
+		return
+	}
+	// (a > @a OR (a = @a AND (b > @b OR (b = @b AND c > @c))))
+	for i := 0; i < n-1; i++ {
+		c.text("(" + k.exprs[i] + op)
+		param(i)
+		c.text(" OR (" + k.exprs[i] + " = ")
+		param(i)
+		c.text(" AND ")
+	}
+	c.text(k.exprs[n-1] + op)
+	param(n - 1)
+	c.text(strings.Repeat("))", n-1))
+}
+
+// concat builds a Go expression that concatenates text with the results of
+// code run when the query is built
+type concat struct {
+	parts []string
+	lit   strings.Builder
+}
+
+func (c *concat) text(s string) {
+	c.lit.WriteString(s)
+}
+
+func (c *concat) code(s string) {
+	c.flush()
+	c.parts = append(c.parts, s)
+}
+
+func (c *concat) flush() {
+	if c.lit.Len() > 0 {
+		c.parts = append(c.parts, strconv.Quote(c.lit.String()))
+		c.lit.Reset()
+	}
+}
+
+func (c *concat) String() string {
+	c.flush()
+	return strings.Join(c.parts, " + ")
+}
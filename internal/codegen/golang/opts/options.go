@@ -25,6 +25,9 @@ type Options struct {
 	EmitEnumValidMethod         bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
 	EmitAllEnumValues           bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
 	EmitSqlAsComment            bool              `json:"emit_sql_as_comment,omitempty" yaml:"emit_sql_as_comment"`
+	EmitSpannerMutations        bool              `json:"emit_spanner_mutations,omitempty" yaml:"emit_spanner_mutations"`
+	SpannerNativeTypes          bool              `json:"spanner_native_types,omitempty" yaml:"spanner_native_types"`
+	EmitSpannerRunInTransaction bool              `json:"emit_spanner_run_in_transaction,omitempty" yaml:"emit_spanner_run_in_transaction"`
 	JsonTagsCaseStyle           string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	Package                     string            `json:"package" yaml:"package"`
 	Out                         string            `json:"out" yaml:"out"`
@@ -37,6 +40,7 @@ type Options struct {
 	OutputModelsFileName        string            `json:"output_models_file_name,omitempty" yaml:"output_models_file_name"`
 	OutputQuerierFileName       string            `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
 	OutputCopyfromFileName      string            `json:"output_copyfrom_file_name,omitempty" yaml:"output_copyfrom_file_name"`
+	OutputMutationsFileName     string            `json:"output_mutations_file_name,omitempty" yaml:"output_mutations_file_name"`
 	OutputFilesSuffix           string            `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
 	InflectionExcludeTableNames []string          `json:"inflection_exclude_table_names,omitempty" yaml:"inflection_exclude_table_names"`
 	WrapErrors                  bool              `json:"wrap_errors,omitempty" yaml:"wrap_errors"`
@@ -144,10 +148,27 @@ func parseGlobalOpts(req *plugin.GenerateRequest) (*GlobalOptions, error) {
 	return &options, nil
 }
 
-func ValidateOpts(opts *Options) error {
+func ValidateOpts(opts *Options, engine string) error {
 	if opts.EmitMethodsWithDbArgument && opts.EmitPreparedQueries {
 		return fmt.Errorf("invalid options: emit_methods_with_db_argument and emit_prepared_queries options are mutually exclusive")
 	}
+	if opts.EmitSpannerRunInTransaction && opts.EmitMethodsWithDbArgument {
+		return fmt.Errorf("invalid options: emit_methods_with_db_argument and emit_spanner_run_in_transaction options are mutually exclusive")
+	}
+	if engine != "spanner" {
+		for _, opt := range []struct {
+			name string
+			set  bool
+		}{
+			{"emit_spanner_mutations", opts.EmitSpannerMutations},
+			{"spanner_native_types", opts.SpannerNativeTypes},
+			{"emit_spanner_run_in_transaction", opts.EmitSpannerRunInTransaction},
+		} {
+			if opt.set {
+				return fmt.Errorf("invalid options: %s is only supported by the spanner engine", opt.name)
+			}
+		}
+	}
 	if *opts.QueryParameterLimit < 0 {
 		return fmt.Errorf("invalid options: query parameter limit must not be negative")
 	}
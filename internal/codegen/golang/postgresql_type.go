@@ -245,7 +245,7 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 		}
 		return "sql.NullTime"
 
-	case "pg_catalog.timestamptz", "timestamptz":
+	case "pg_catalog.timestamptz", "timestamptz", "spanner.commit_timestamp":
 		if driver == opts.SQLDriverPGXV5 {
 			return "pgtype.Timestamptz"
 		}
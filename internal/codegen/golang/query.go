@@ -2,6 +2,7 @@ package golang
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
@@ -18,6 +19,7 @@ type QueryValue struct {
 	Typ         string
 	SQLDriver   opts.SQLDriver
 	Engine      string // The SQL engine (postgresql, mysql, sqlite, spanner)
+	Dialect     string // The SQL dialect of a Spanner database (googlesql, postgresql)
 
 	// Column is kept so late in the generation process around to differentiate
 	// between mysql slices and pg arrays
@@ -124,20 +126,56 @@ func (v QueryValue) UniqueFields() []Field {
 	return fields
 }
 
+// usesNamedArgs reports whether query arguments are bound by name instead of
+// by position. Spanner queries keep their @name placeholders, so a parameter
+// used more than once is passed a single time as sql.Named. The PostgreSQL
+// dialect numbers its placeholders instead.
+func (v QueryValue) usesNamedArgs() bool {
+	return v.Engine == "spanner" && v.Dialect != "postgresql" && !v.SQLDriver.IsPGX()
+}
+
+// usesPQArray reports whether slices are bound and scanned through pq.Array.
+// pgx and the Spanner driver, in either dialect, handle slices natively.
+func (v QueryValue) usesPQArray() bool {
+	return v.Engine != "spanner" && !v.SQLDriver.IsPGX()
+}
+
+func (v QueryValue) namedParams() []string {
+	var out []string
+	seen := map[string]struct{}{}
+	add := func(name, value string) {
+		if _, found := seen[name]; found {
+			return
+		}
+		seen[name] = struct{}{}
+		out = append(out, fmt.Sprintf("sql.Named(%q, %s)", name, value))
+	}
+	if v.Struct == nil {
+		add(v.Column.GetName(), escape(v.Name))
+	} else {
+		for _, f := range v.Struct.Fields {
+			add(f.Column.GetName(), escape(v.VariableForField(f)))
+		}
+	}
+	return out
+}
+
 func (v QueryValue) Params() string {
 	if v.isEmpty() {
 		return ""
 	}
 	var out []string
-	if v.Struct == nil {
-		if !v.Column.IsSqlcSlice && strings.HasPrefix(v.Typ, "[]") && v.Typ != "[]byte" && !v.SQLDriver.IsPGX() {
+	if v.usesNamedArgs() {
+		out = v.namedParams()
+	} else if v.Struct == nil {
+		if !v.Column.IsSqlcSlice && strings.HasPrefix(v.Typ, "[]") && v.Typ != "[]byte" && v.usesPQArray() {
 			out = append(out, "pq.Array("+escape(v.Name)+")")
 		} else {
 			out = append(out, escape(v.Name))
 		}
 	} else {
 		for _, f := range v.Struct.Fields {
-			if !f.HasSqlcSlice() && strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && !v.SQLDriver.IsPGX() {
+			if !f.HasSqlcSlice() && strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && v.usesPQArray() {
 				out = append(out, "pq.Array("+escape(v.VariableForField(f))+")")
 			} else {
 				out = append(out, escape(v.VariableForField(f)))
@@ -180,6 +218,10 @@ func (v QueryValue) ColumnNamesAsGoSlice() string {
 // When true, we have to build the arguments to q.db.QueryContext in addition to
 // munging the SQL
 func (v QueryValue) HasSqlcSlices() bool {
+	if v.usesNamedArgs() {
+		// Spanner passes a slice as a single ARRAY parameter
+		return false
+	}
 	if v.Struct == nil {
 		return v.Column != nil && v.Column.IsSqlcSlice
 	}
@@ -191,10 +233,47 @@ func (v QueryValue) HasSqlcSlices() bool {
 	return false
 }
 
+// HasSqlcOptionals reports whether the query has sqlc.optional() parameters,
+// in which case the SQL and its arguments are built at run time
+func (v QueryValue) HasSqlcOptionals() bool {
+	if v.Struct == nil {
+		return v.Column != nil && v.Column.IsSqlcOptional
+	}
+	for _, f := range v.Struct.Fields {
+		if f.HasSqlcOptional() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSqlcOrderBys reports whether the query has sqlc.orderby() parameters,
+// in which case the SQL is built at run time
+func (v QueryValue) HasSqlcOrderBys() bool {
+	if v.Struct == nil {
+		return v.Column != nil && len(v.Column.OrderByKeys) > 0
+	}
+	for _, f := range v.Struct.Fields {
+		if f.HasSqlcOrderBy() {
+			return true
+		}
+	}
+	return false
+}
+
+// Bind returns the argument that binds value to the parameter name in a query
+// built at run time
+func (v QueryValue) Bind(value, name string) string {
+	if v.usesNamedArgs() {
+		return fmt.Sprintf("sql.Named(%q, %s)", name, escape(value))
+	}
+	return escape(value)
+}
+
 func (v QueryValue) Scan() string {
 	var out []string
 	if v.Struct == nil {
-		if strings.HasPrefix(v.Typ, "[]") && v.Typ != "[]byte" && !v.SQLDriver.IsPGX() {
+		if strings.HasPrefix(v.Typ, "[]") && v.Typ != "[]byte" && v.usesPQArray() {
 			out = append(out, "pq.Array(&"+v.Name+")")
 		} else {
 			out = append(out, "&"+v.Name)
@@ -205,7 +284,7 @@ func (v QueryValue) Scan() string {
 			// append any embedded fields
 			if len(f.EmbedFields) > 0 {
 				for _, embed := range f.EmbedFields {
-					if strings.HasPrefix(embed.Type, "[]") && embed.Type != "[]byte" && !v.SQLDriver.IsPGX() {
+					if strings.HasPrefix(embed.Type, "[]") && embed.Type != "[]byte" && v.usesPQArray() {
 						out = append(out, "pq.Array(&"+v.Name+"."+f.Name+"."+embed.Name+")")
 					} else {
 						out = append(out, "&"+v.Name+"."+f.Name+"."+embed.Name)
@@ -214,7 +293,7 @@ func (v QueryValue) Scan() string {
 				continue
 			}
 
-			if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && !v.SQLDriver.IsPGX() {
+			if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && v.usesPQArray() {
 				out = append(out, "pq.Array(&"+v.Name+"."+f.Name+")")
 			} else {
 				out = append(out, "&"+v.Name+"."+f.Name)
@@ -266,6 +345,12 @@ type Query struct {
 	SourceName   string
 	Ret          QueryValue
 	Arg          QueryValue
+	// Struct types of Spanner STRUCT parameters
+	ParamStructs []Struct
+	// Sort key types of sqlc.orderby() parameters
+	OrderBys []Enum
+	// Cursor of a query paginated with @paginate keyset(...)
+	Keyset *Keyset
 	// Used for :copyfrom
 	Table *plugin.Identifier
 	// Engine is the SQL engine (postgresql, mysql, sqlite, spanner)
@@ -274,10 +359,119 @@ type Query struct {
 
 func (q Query) hasRetType() bool {
 	scanned := q.Cmd == metadata.CmdOne || q.Cmd == metadata.CmdMany ||
-		q.Cmd == metadata.CmdBatchMany || q.Cmd == metadata.CmdBatchOne
+		q.Cmd == metadata.CmdBatchMany || q.Cmd == metadata.CmdBatchOne ||
+		q.Cmd == metadata.CmdPartitioned || q.Cmd == metadata.CmdIter
 	return scanned && !q.Ret.isEmpty()
 }
 
+// DecodesChangeRecords reports whether a query reads the ChangeRecord column
+// of a Spanner change stream. The driver only returns ARRAY<STRUCT> values as
+// spanner.GenericColumnValue, with spannerdriver.DecodeOptionProto.
+func (q Query) DecodesChangeRecords() bool {
+	if !q.hasRetType() {
+		return false
+	}
+	if q.Ret.Struct == nil {
+		return q.Ret.Typ == "ChangeRecords"
+	}
+	for _, f := range q.Ret.Struct.Fields {
+		if f.Type == "ChangeRecords" {
+			return true
+		}
+	}
+	return false
+}
+
+// QueryArgs returns the arguments that follow the context in the call that
+// runs the query
+func (q Query) QueryArgs() string {
+	if q.Arg.HasSqlcOptionals() || q.Arg.HasSqlcOrderBys() || q.Keyset != nil {
+		return "query, queryParams..."
+	}
+	return q.ConstantName + ", " + q.Arg.Params()
+}
+
+// optionalPredicate returns the predicate of the sqlc.optional() parameter
+// name, with and without the comments that mark it. The markers are written
+// by internal/sql/rewrite.OptionalPredicates.
+func (q Query) optionalPredicate(name string) (string, string) {
+	start := "/*OPTIONAL:" + name + "*/"
+	i := strings.Index(q.SQL, start)
+	if i < 0 {
+		return "", ""
+	}
+	n := strings.Index(q.SQL[i:], "/*END*/")
+	if n < 0 {
+		return "", ""
+	}
+	return q.SQL[i : i+n+len("/*END*/")], q.SQL[i+len(start) : i+n]
+}
+
+var numberedPlaceholder = regexp.MustCompile(`[$?]\d+`)
+
+// renumberedPredicate returns a Go expression for the predicate of the
+// sqlc.optional() parameter name, with its placeholder numbered after the
+// query parameters bound so far. It's empty for engines whose placeholders
+// aren't numbered.
+func (q Query) renumberedPredicate(name string) string {
+	_, pred := q.optionalPredicate(name)
+	locs := numberedPlaceholder.FindAllStringIndex(pred, -1)
+	if len(locs) == 0 {
+		return ""
+	}
+	loc := locs[len(locs)-1]
+	expr := fmt.Sprintf("%q + strconv.Itoa(len(queryParams))", pred[:loc[0]+1])
+	if loc[1] < len(pred) {
+		expr += fmt.Sprintf(" + %q", pred[loc[1]:])
+	}
+	return expr
+}
+
+// BindOptional returns the code that binds value to the sqlc.optional()
+// parameter name, or replaces its predicate with TRUE when value is nil.
+func (q Query) BindOptional(value, name string) string {
+	marked, _ := q.optionalPredicate(name)
+	value = escape(value)
+	var b strings.Builder
+	fmt.Fprintf(&b, "if %s != nil {\n", value)
+	fmt.Fprintf(&b, "queryParams = append(queryParams, %s)\n", q.Arg.Bind("*"+value, name))
+	if expr := q.renumberedPredicate(name); expr != "" {
+		fmt.Fprintf(&b, "query = strings.Replace(query, %q, %s, 1)\n", marked, expr)
+	}
+	b.WriteString("} else {\n")
+	fmt.Fprintf(&b, "query = strings.Replace(query, %q, \"TRUE\", 1)\n", marked)
+	b.WriteString("}")
+	return b.String()
+}
+
+// SpliceOrderBy returns the code that replaces the first sort key of the
+// sqlc.orderby() parameter col with value, when value is one of its keys.
+// The marker is written by internal/sql/rewrite.OrderBys.
+func (q Query) SpliceOrderBy(value string, col *plugin.Column) string {
+	marked := "/*ORDERBY:" + col.Name + "*/" + col.OrderByKeys[0]
+	value = escape(value)
+	var b strings.Builder
+	fmt.Fprintf(&b, "if %s.Valid() {\n", value)
+	fmt.Fprintf(&b, "query = strings.Replace(query, %q, string(%s), 1)\n", marked, value)
+	b.WriteString("}")
+	return b.String()
+}
+
+func (q Query) renumbersOptionals() bool {
+	if q.Arg.isEmpty() {
+		return false
+	}
+	if q.Arg.Struct == nil {
+		return q.Arg.Column.GetIsSqlcOptional() && q.renumberedPredicate(q.Arg.Column.GetName()) != ""
+	}
+	for _, f := range q.Arg.Struct.Fields {
+		if f.HasSqlcOptional() && q.renumberedPredicate(f.Column.GetName()) != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func (q Query) TableIdentifierAsGoSlice() string {
 	escapedNames := make([]string, 0, 3)
 	for _, p := range []string{q.Table.Catalog, q.Table.Schema, q.Table.Name} {
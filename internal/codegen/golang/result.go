@@ -6,6 +6,8 @@ import (
 	"sort"
 	"strings"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
 	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
 	"github.com/sqlc-dev/sqlc/internal/inflection"
@@ -231,7 +233,24 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 
 		qpl := int(*options.QueryParameterLimit)
 
-		if len(query.Params) == 1 && qpl != 0 {
+		cols, paramStructs, err := paramColumns(req, options, gq.MethodName, query.Params)
+		if err != nil {
+			return nil, err
+		}
+		gq.ParamStructs = paramStructs
+
+		if len(cols) == 1 && qpl != 0 && cols[0].embed != nil {
+			c := cols[0]
+			gq.Arg = QueryValue{
+				Name:      escape(argName(c.Name)),
+				DBName:    c.Name,
+				Typ:       c.embed.modelType,
+				SQLDriver: sqlpkg,
+				Engine:    req.Settings.Engine,
+				Dialect:   req.Settings.Dialect,
+				Column:    c.Column,
+			}
+		} else if len(cols) == 1 && qpl != 0 {
 			p := query.Params[0]
 			gq.Arg = QueryValue{
 				Name:      escape(paramName(p)),
@@ -239,16 +258,10 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 				Typ:       goType(req, options, p.Column),
 				SQLDriver: sqlpkg,
 				Engine:    req.Settings.Engine,
+				Dialect:   req.Settings.Dialect,
 				Column:    p.Column,
 			}
-		} else if len(query.Params) >= 1 {
-			var cols []goColumn
-			for _, p := range query.Params {
-				cols = append(cols, goColumn{
-					id:     int(p.Number),
-					Column: p.Column,
-				})
-			}
+		} else if len(cols) >= 1 {
 			s, err := columnsToStruct(req, options, gq.MethodName+"Params", cols, false)
 			if err != nil {
 				return nil, err
@@ -259,15 +272,19 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 				Struct:      s,
 				SQLDriver:   sqlpkg,
 				Engine:      req.Settings.Engine,
+				Dialect:     req.Settings.Dialect,
 				EmitPointer: options.EmitParamsStructPointers,
 			}
 
 			// if query params is 2, and query params limit is 4 AND this is a copyfrom, we still want to emit the query's model
 			// otherwise we end up with a copyfrom using a struct without the struct definition
-			if len(query.Params) <= qpl && query.Cmd != ":copyfrom" {
+			if len(cols) <= qpl && query.Cmd != ":copyfrom" {
 				gq.Arg.Emit = false
 			}
 		}
+		if gq.Arg.HasSqlcOrderBys() {
+			gq.OrderBys = orderByEnums(options, &gq)
+		}
 
 		if len(query.Columns) == 1 && query.Columns[0].EmbedTable == nil {
 			c := query.Columns[0]
@@ -279,6 +296,7 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 				Typ:       goType(req, options, c),
 				SQLDriver: sqlpkg,
 				Engine:    req.Settings.Engine,
+				Dialect:   req.Settings.Dialect,
 			}
 		} else if putOutColumns(query) {
 			var gs *Struct
@@ -326,21 +344,69 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 				Struct:      gs,
 				SQLDriver:   sqlpkg,
 				Engine:      req.Settings.Engine,
+				Dialect:     req.Settings.Dialect,
 				EmitPointer: options.EmitResultStructPointers,
 			}
 		}
 
+		if len(query.Keyset) > 0 {
+			keyset, err := buildKeyset(req, options, &gq, query)
+			if err != nil {
+				return nil, err
+			}
+			gq.Keyset = keyset
+		}
+
 		qs = append(qs, gq)
 	}
 	sort.Slice(qs, func(i, j int) bool { return qs[i].MethodName < qs[j].MethodName })
 	return qs, nil
 }
 
+// orderByEnums returns the sort key types of the sqlc.orderby() parameters of
+// a query, and gives those types to the parameters
+func orderByEnums(options *opts.Options, q *Query) []Enum {
+	var enums []Enum
+	enum := func(col *plugin.Column) string {
+		name := q.MethodName + StructName(col.Name, options)
+		e := Enum{
+			Name:    name,
+			Comment: fmt.Sprintf("%s is a sort key of %s", name, q.MethodName),
+		}
+		for _, key := range col.OrderByKeys {
+			parts := strings.Fields(key)
+			for i := 1; i < len(parts); i++ {
+				parts[i] = strings.ToLower(parts[i])
+			}
+			e.Constants = append(e.Constants, Constant{
+				Name:  name + EnumValueName(strings.Join(parts, "_")),
+				Type:  name,
+				Value: key,
+			})
+		}
+		enums = append(enums, e)
+		return name
+	}
+	if q.Arg.Struct == nil {
+		q.Arg.Typ = enum(q.Arg.Column)
+		return enums
+	}
+	for i, f := range q.Arg.Struct.Fields {
+		if f.HasSqlcOrderBy() {
+			q.Arg.Struct.Fields[i].Type = enum(f.Column)
+		}
+	}
+	return enums
+}
+
 var cmdReturnsData = map[string]struct{}{
 	metadata.CmdBatchMany: {},
 	metadata.CmdBatchOne:  {},
 	metadata.CmdMany:      {},
 	metadata.CmdOne:       {},
+
+	metadata.CmdIter:        {},
+	metadata.CmdPartitioned: {},
 }
 
 func putOutColumns(query *plugin.Query) bool {
@@ -442,6 +508,78 @@ func columnsToStruct(req *plugin.GenerateRequest, options *opts.Options, name st
 	return &gs, nil
 }
 
+// paramColumns combines the fields of each Spanner STRUCT parameter into a struct.
+func paramColumns(req *plugin.GenerateRequest, options *opts.Options, queryName string, params []*plugin.Parameter) ([]goColumn, []Struct, error) {
+	var cols []goColumn
+	scopes := map[string]int{}
+	fields := map[string][]goColumn{}
+	seen := map[string]struct{}{}
+	for _, p := range params {
+		scope := p.Column.GetScope()
+		if scope == "" {
+			cols = append(cols, goColumn{
+				id:     int(p.Number),
+				Column: p.Column,
+			})
+			continue
+		}
+		if _, found := scopes[scope]; !found {
+			scopes[scope] = len(cols)
+			cols = append(cols, goColumn{
+				id: int(p.Number),
+				Column: &plugin.Column{
+					Name:         scope,
+					NotNull:      true,
+					IsNamedParam: true,
+				},
+			})
+		}
+		if _, found := seen[scope+"."+p.Column.Name]; found {
+			continue
+		}
+		seen[scope+"."+p.Column.Name] = struct{}{}
+		field := p.Column
+		if field.IsSqlcSlice {
+			// ARRAY<STRUCT<...>>, as in (a, b) IN UNNEST(@pairs), is a slice
+			// of structs rather than a struct of slices
+			cols[scopes[scope]].IsSqlcSlice = true
+			field = proto.Clone(field).(*plugin.Column)
+			field.IsSqlcSlice = false
+		}
+		fields[scope] = append(fields[scope], goColumn{
+			id:     int(p.Number),
+			Column: field,
+		})
+	}
+
+	var structs []Struct
+	for i, c := range cols {
+		if _, found := fields[c.Name]; !found {
+			continue
+		}
+		s, err := columnsToStruct(req, options, queryName+StructName(c.Name, options), fields[c.Name], false)
+		if err != nil {
+			return nil, nil, err
+		}
+		// The Spanner client encodes a Go struct as a STRUCT, naming its
+		// fields after their spanner tags
+		for _, f := range s.Fields {
+			f.Tags["spanner"] = f.DBName
+		}
+		typ := s.Name
+		if c.IsSqlcSlice {
+			typ = "[]" + typ
+		}
+		cols[i].embed = &goEmbed{
+			modelType: typ,
+			modelName: c.Name,
+			fields:    s.Fields,
+		}
+		structs = append(structs, *s)
+	}
+	return cols, structs, nil
+}
+
 func checkIncompatibleFieldTypes(fields []Field) error {
 	fieldTypes := map[string]string{}
 	for _, field := range fields {
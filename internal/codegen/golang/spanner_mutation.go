@@ -0,0 +1,85 @@
+package golang
+
+import (
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// SpannerMutation describes the Insert, Update and InsertOrUpdate mutation
+// builders generated for a table.
+type SpannerMutation struct {
+	Struct  Struct
+	Table   string
+	Columns []string
+	// Commit timestamp columns, which are written with spanner.CommitTimestamp
+	// unless a value is set
+	CommitTimestamps []CommitTimestampField
+}
+
+type CommitTimestampField struct {
+	Index int
+	Field Field
+}
+
+// IsZero is the Go expression that reports whether the field of v is unset,
+// or an empty string if the field is always written as the commit timestamp.
+func (f CommitTimestampField) IsZero(v string) string {
+	name := v + "." + f.Field.Name
+	switch f.Field.Type {
+	case "time.Time":
+		return name + ".IsZero()"
+	case "*time.Time":
+		return name + " == nil"
+	case "sql.NullTime", "pgtype.Timestamptz", "pgtype.Timestamp":
+		return "!" + name + ".Valid"
+	}
+	return ""
+}
+
+func (m SpannerMutation) ColumnsVar() string {
+	return sdk.LowerTitle(m.Struct.Name) + "MutationColumns"
+}
+
+func (m SpannerMutation) ValuesFunc() string {
+	return sdk.LowerTitle(m.Struct.Name) + "MutationValues"
+}
+
+func buildSpannerMutations(req *plugin.GenerateRequest, structs []Struct) []SpannerMutation {
+	tables := map[string]*plugin.Table{}
+	for _, schema := range req.Catalog.Schemas {
+		for _, table := range schema.Tables {
+			tables[schema.Name+"."+table.Rel.Name] = table
+		}
+	}
+
+	var mutations []SpannerMutation
+	for _, s := range structs {
+		if s.Table == nil {
+			continue
+		}
+		table, ok := tables[s.Table.Schema+"."+s.Table.Name]
+		if !ok || len(table.Columns) != len(s.Fields) {
+			continue
+		}
+		m := SpannerMutation{
+			Struct: s,
+			Table:  s.Table.Name,
+		}
+		if s.Table.Schema != "" && s.Table.Schema != req.Catalog.DefaultSchema {
+			m.Table = fmt.Sprintf("%s.%s", s.Table.Schema, s.Table.Name)
+		}
+		for i, col := range table.Columns {
+			m.Columns = append(m.Columns, col.Name)
+			if col.AllowCommitTimestamp {
+				m.CommitTimestamps = append(m.CommitTimestamps, CommitTimestampField{
+					Index: i,
+					Field: s.Fields[i],
+				})
+			}
+		}
+		mutations = append(mutations, m)
+	}
+	return mutations
+}
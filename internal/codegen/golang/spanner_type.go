@@ -13,17 +13,11 @@ import (
 // https://pkg.go.dev/cloud.google.com/go/spanner#hdr-Updating_a_row
 func spannerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) string {
 	dt := strings.ToLower(sdk.DataType(col.Type))
+	// goType makes slices of the elements of ARRAY<T> columns, and a NULL
+	// array is a nil slice
 	notNull := col.NotNull || col.IsArray
 	emitPointersForNull := options.EmitPointersForNullTypes
-
-	// Handle array types
-	if col.IsArray {
-		baseType := spannerType(req, options, &plugin.Column{
-			Type:    col.Type,
-			NotNull: true,
-		})
-		return "[]" + baseType
-	}
+	nativeTypes := options.SpannerNativeTypes
 
 	// Handle sized types (e.g., STRING(100), STRING(MAX))
 	if idx := strings.Index(dt, "("); idx > 0 {
@@ -50,6 +44,9 @@ func spannerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin
 		if emitPointersForNull {
 			return "*float32"
 		}
+		if nativeTypes {
+			return "spanner.NullFloat32"
+		}
 		return "sql.NullFloat64" // No NullFloat32 in database/sql
 
 	case "float", "float64":
@@ -66,6 +63,15 @@ func spannerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin
 	case "numeric":
 		// NUMERIC - uses big.Rat in Spanner Go client
 		// For database/sql compatibility, we use string to preserve precision
+		if nativeTypes {
+			if notNull {
+				return "big.Rat"
+			}
+			if emitPointersForNull {
+				return "*big.Rat"
+			}
+			return "spanner.NullNumeric"
+		}
 		if notNull {
 			return "string" // Preserve precision as string
 		}
@@ -103,6 +109,15 @@ func spannerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin
 	case "date":
 		// DATE - uses civil.Date in Spanner Go client
 		// For database/sql compatibility, use time.Time
+		if nativeTypes {
+			if notNull {
+				return "civil.Date"
+			}
+			if emitPointersForNull {
+				return "*civil.Date"
+			}
+			return "spanner.NullDate"
+		}
 		if notNull {
 			return "time.Time"
 		}
@@ -125,6 +140,10 @@ func spannerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin
 	case "json", "jsonb":
 		// JSON - Spanner JSON type
 		// Using json.RawMessage for database/sql compatibility
+		if nativeTypes {
+			// The Spanner client only decodes JSON into NullJSON
+			return "spanner.NullJSON"
+		}
 		return "json.RawMessage"
 
 	case "interval":
@@ -140,9 +159,24 @@ func spannerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin
 		//     go_type: "cloud.google.com/go/spanner.Interval"
 		//
 		// This approach keeps the generated code package-agnostic by default
-		// while allowing users to opt into the Spanner-specific types when needed.
+		// while allowing users to opt into the Spanner-specific types when needed,
+		// or into all of them with spanner_native_types.
+		if nativeTypes {
+			if notNull {
+				return "spanner.Interval"
+			}
+			if emitPointersForNull {
+				return "*spanner.Interval"
+			}
+			return "spanner.NullInterval"
+		}
 		return "interface{}"
 
+	case "change_record":
+		// The ChangeRecord column of a change stream's READ_ function, decoded
+		// into the record types generated with the models
+		return "ChangeRecords"
+
 	case "any":
 		return "interface{}"
 
@@ -151,3 +185,18 @@ func spannerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin
 		return "interface{}"
 	}
 }
+
+// spannerPostgresType maps the types of a Spanner database using the
+// PostgreSQL dialect. Most of them read and write like their PostgreSQL
+// counterparts, but the Spanner driver only binds NUMERIC and JSONB values
+// given as spanner.PGNumeric and spanner.PGJsonB.
+// https://cloud.google.com/spanner/docs/reference/postgresql/data-types
+func spannerPostgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) string {
+	switch strings.ToLower(sdk.DataType(col.Type)) {
+	case "numeric", "pg_catalog.numeric":
+		return "spanner.PGNumeric"
+	case "json", "pg_catalog.json", "jsonb", "pg_catalog.jsonb":
+		return "spanner.PGJsonB"
+	}
+	return postgresType(req, options, col)
+}
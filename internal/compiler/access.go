@@ -0,0 +1,271 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// With Spanner fine-grained access control, a database role can only read and
+// write the tables and columns it has been granted access to. When a package
+// sets database_role, every query is checked against the role's grants,
+// including the grants of the roles it inherits.
+//
+// https://cloud.google.com/spanner/docs/fgac-about
+type roleAccess struct {
+	role       string
+	privileges []*catalog.Privilege
+}
+
+func (c *Compiler) databaseRole() (*roleAccess, error) {
+	name := c.conf.DatabaseRole
+	if _, ok := c.catalog.GetRole(name); !ok {
+		return nil, fmt.Errorf("database role %q does not exist", name)
+	}
+	access := &roleAccess{role: name}
+	seen := map[string]struct{}{}
+	var inherit func(string)
+	inherit = func(name string) {
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		role, ok := c.catalog.GetRole(name)
+		if !ok {
+			return
+		}
+		access.privileges = append(access.privileges, role.Privileges...)
+		for _, r := range role.Roles {
+			inherit(r)
+		}
+	}
+	inherit(name)
+	return access, nil
+}
+
+func (a *roleAccess) allowed(priv string, objtype ast.GrantObjectType, rel *ast.TableName, col string) bool {
+	for _, p := range a.privileges {
+		if p.Name != priv || !strings.EqualFold(p.Rel.Name, rel.Name) || p.Rel.Schema != rel.Schema {
+			continue
+		}
+		switch {
+		case p.Objtype == objtype:
+		case objtype == ast.GrantObjectTypeTable && p.Objtype == ast.GrantObjectTypeView:
+		default:
+			continue
+		}
+		if len(p.Columns) == 0 {
+			return true
+		}
+		for _, c := range p.Columns {
+			if c == col {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (a *roleAccess) denied(priv, object string, location int) error {
+	return &sqlerr.Error{
+		Message:  fmt.Sprintf("database role %q does not have %s privilege on %s", a.role, strings.ToUpper(priv), object),
+		Location: location,
+	}
+}
+
+func (a *roleAccess) checkColumn(priv string, table *catalog.Table, col string, location int) error {
+	if a.allowed(priv, ast.GrantObjectTypeTable, table.Rel, col) {
+		return nil
+	}
+	return a.denied(priv, fmt.Sprintf("column %q of table %q", col, table.Rel.Name), location)
+}
+
+func (c *Compiler) checkAccess(rvs []*ast.RangeVar, node ast.Node) error {
+	access, err := c.databaseRole()
+	if err != nil {
+		return err
+	}
+
+	// Tables referenced by the query, by name and alias
+	var tables []*catalog.Table
+	aliases := map[string]*catalog.Table{}
+	lookup := func(rv *ast.RangeVar) *catalog.Table {
+		if rv == nil || rv.Relname == nil {
+			return nil
+		}
+		fqn, err := ParseTableName(rv)
+		if err != nil {
+			return nil
+		}
+		table, err := c.catalog.GetTable(fqn)
+		if err != nil {
+			// Common table expressions and unknown tables
+			return nil
+		}
+		return &table
+	}
+	for _, rv := range rvs {
+		table := lookup(rv)
+		if table == nil {
+			continue
+		}
+		tables = append(tables, table)
+		aliases[table.Rel.Name] = table
+		if rv.Alias != nil && rv.Alias.Aliasname != nil {
+			aliases[*rv.Alias.Aliasname] = table
+		}
+		if table.Query != nil && table.SecurityInvoker {
+			if err := c.checkViewAccess(table, rv.Location); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Columns written by the statement
+	switch n := node.(type) {
+	case *ast.InsertStmt:
+		if table := lookup(n.Relation); table != nil && n.Cols != nil {
+			for _, item := range n.Cols.Items {
+				res, ok := item.(*ast.ResTarget)
+				if !ok || res.Name == nil {
+					continue
+				}
+				if err := access.checkColumn("insert", table, *res.Name, res.Location); err != nil {
+					return err
+				}
+			}
+		}
+	case *ast.UpdateStmt:
+		if n.Relations != nil && len(n.Relations.Items) == 1 && n.TargetList != nil {
+			rv, _ := n.Relations.Items[0].(*ast.RangeVar)
+			if table := lookup(rv); table != nil {
+				for _, item := range n.TargetList.Items {
+					res, ok := item.(*ast.ResTarget)
+					if !ok || res.Name == nil {
+						continue
+					}
+					if err := access.checkColumn("update", table, *res.Name, res.Location); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	case *ast.DeleteStmt:
+		if n.Relations != nil {
+			for _, item := range n.Relations.Items {
+				rv, _ := item.(*ast.RangeVar)
+				table := lookup(rv)
+				if table == nil {
+					continue
+				}
+				if !access.allowed("delete", ast.GrantObjectTypeTable, table.Rel, "") {
+					return access.denied("delete", fmt.Sprintf("table %q", table.Rel.Name), rv.Location)
+				}
+			}
+		}
+	}
+
+	// Columns and change streams read by the statement
+	var rerr error
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		if rerr != nil {
+			return
+		}
+		switch n := node.(type) {
+		case *ast.ColumnRef:
+			rerr = access.checkColumnRef(n, tables, aliases)
+		case *ast.RangeFunction:
+			rerr = access.checkChangeStream(n)
+		}
+	}), node)
+	return rerr
+}
+
+// Querying a SQL SECURITY INVOKER view also requires access to everything the
+// view reads. Errors are reported at the reference to the view, as the view's
+// query is in the schema.
+func (c *Compiler) checkViewAccess(view *catalog.Table, location int) error {
+	err := c.checkAccess(rangeVars(view.Query), view.Query)
+	var serr *sqlerr.Error
+	if errors.As(err, &serr) {
+		return &sqlerr.Error{
+			Message:  fmt.Sprintf("%s, read by view %q", serr.Message, view.Rel.Name),
+			Location: location,
+		}
+	}
+	return err
+}
+
+func (a *roleAccess) checkColumnRef(ref *ast.ColumnRef, tables []*catalog.Table, aliases map[string]*catalog.Table) error {
+	if ref.Fields == nil {
+		return nil
+	}
+	var parts []string
+	star := false
+	for _, item := range ref.Fields.Items {
+		switch f := item.(type) {
+		case *ast.String:
+			parts = append(parts, f.Str)
+		case *ast.A_Star:
+			star = true
+		}
+	}
+
+	candidates := tables
+	if len(parts) > 1 || (star && len(parts) == 1) {
+		table, ok := aliases[parts[0]]
+		if !ok {
+			return nil
+		}
+		candidates = []*catalog.Table{table}
+		parts = parts[1:]
+	}
+
+	for _, table := range candidates {
+		for _, col := range table.Columns {
+			if star || (len(parts) > 0 && col.Name == parts[0]) {
+				if err := a.checkColumn("select", table, col.Name, ref.Location); err != nil {
+					return err
+				}
+				if !star {
+					return nil
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Reading a change stream requires SELECT on the change stream and EXECUTE on
+// its READ_ function.
+func (a *roleAccess) checkChangeStream(rf *ast.RangeFunction) error {
+	if rf.Functions == nil {
+		return nil
+	}
+	for _, item := range rf.Functions.Items {
+		if list, ok := item.(*ast.List); ok && len(list.Items) > 0 {
+			item = list.Items[0]
+		}
+		call, ok := item.(*ast.FuncCall)
+		if !ok || call.Func == nil {
+			continue
+		}
+		name := strings.ToLower(call.Func.Name)
+		stream, ok := strings.CutPrefix(name, "read_")
+		if !ok {
+			continue
+		}
+		if !a.allowed("select", ast.GrantObjectTypeChangeStream, &ast.TableName{Name: stream}, "") {
+			return a.denied("select", fmt.Sprintf("change stream %q", stream), call.Location)
+		}
+		if !a.allowed("execute", ast.GrantObjectTypeFunction, &ast.TableName{Name: name}, "") {
+			return a.denied("execute", fmt.Sprintf("table function %q", call.Func.Name), call.Location)
+		}
+	}
+	return nil
+}
@@ -94,6 +94,14 @@ func combineAnalysis(prev *analysis, a *analyzer.Analysis) *analysis {
 			prev.Columns = cols
 		}
 	}
+	// The database doesn't know about sqlc.orderby() parameters, as they aren't
+	// bound to the query
+	n := len(prev.Parameters)
+	for n > 0 && len(prev.Parameters[n-1].Column.OrderByKeys) > 0 {
+		n--
+	}
+	orderBys := prev.Parameters[n:]
+	prev.Parameters = prev.Parameters[:n]
 	if len(prev.Parameters) == len(params) {
 		for i := range prev.Parameters {
 			prev.Parameters[i].Column.DataType = params[i].Column.DataType
@@ -103,6 +111,7 @@ func combineAnalysis(prev *analysis, a *analyzer.Analysis) *analysis {
 	} else {
 		prev.Parameters = params
 	}
+	prev.Parameters = append(prev.Parameters, orderBys...)
 	return prev
 }
 
@@ -131,7 +140,19 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 		return nil, err
 	}
 
-	raw, namedParams, edits := rewrite.NamedParameters(c.conf.Engine, raw, numbers, dollar)
+	optionalEdits, err := rewrite.OptionalPredicates(raw, query)
+	if err := check(err); err != nil {
+		return nil, err
+	}
+
+	orderBys, orderByEdits, err := rewrite.OrderBys(raw, query)
+	if err := check(err); err != nil {
+		return nil, err
+	}
+
+	raw, namedParams, edits := rewrite.NamedParameters(c.conf.QueryEngine(), raw, numbers, dollar)
+	edits = append(edits, optionalEdits...)
+	edits = append(edits, orderByEdits...)
 
 	var table *ast.TableName
 	switch n := raw.Stmt.(type) {
@@ -153,7 +174,15 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 	if err := check(validate.In(c.catalog, raw)); err != nil {
 		return nil, err
 	}
+	if c.conf.Engine == config.EngineSpanner {
+		if err := check(validate.CommitTimestamp(c.catalog, raw.Stmt)); err != nil {
+			return nil, err
+		}
+	}
 	rvs := rangeVars(raw.Stmt)
+	if c.conf.Engine == config.EngineSpanner {
+		c.rewriteJSONPaths(rvs, raw)
+	}
 	refs, errs := findParameters(raw.Stmt)
 	if len(errs) > 0 {
 		if failfast {
@@ -177,10 +206,25 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 	if err := check(err); err != nil {
 		return nil, err
 	}
+	if c.conf.Engine == config.EngineSpanner {
+		c.spannerParamTypes(qc, raw.Stmt, refs, params)
+	}
 	cols, err := c.outputColumns(qc, raw.Stmt)
 	if err := check(err); err != nil {
 		return nil, err
 	}
+	if len(orderBys) > 0 {
+		sortParams, err := orderByParams(orderBys, cols, params)
+		if err := check(err); err != nil {
+			return nil, err
+		}
+		params = append(params, sortParams...)
+	}
+	if c.conf.Engine == config.EngineSpanner && c.conf.DatabaseRole != "" {
+		if err := check(c.checkAccess(rvs, raw.Stmt)); err != nil {
+			return nil, err
+		}
+	}
 
 	expandEdits, err := c.expand(qc, raw)
 	if check(err); err != nil {
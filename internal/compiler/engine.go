@@ -60,8 +60,14 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings) (*Compiler, err
 			}
 		}
 	case config.EngineSpanner:
-		c.parser = spanner.NewParser()
-		c.catalog = spanner.NewCatalog()
+		switch conf.Dialect {
+		case config.DialectPostgreSQL:
+			c.parser = spanner.NewPostgreSQLParser()
+			c.catalog = spanner.NewPostgreSQLCatalog()
+		default:
+			c.parser = spanner.NewParser()
+			c.catalog = spanner.NewCatalog()
+		}
 		c.selector = newDefaultSelector()
 	default:
 		return nil, fmt.Errorf("unknown engine: %s", conf.Engine)
@@ -0,0 +1,395 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+// In GoogleSQL the result type of CASE, COALESCE and functions such as
+// GREATEST, ARRAY_AGG or MAX is the supertype of their arguments. Spanner
+// functions declare these arguments and results as "any" (or "array" for an
+// array of them) in the catalog, and the Spanner engine computes the actual
+// type from the arguments of each call.
+//
+// https://cloud.google.com/spanner/docs/reference/standard-sql/conversion_rules#supertypes
+type exprType struct {
+	// An empty DataType is an untyped NULL or a parameter, which takes the
+	// type of the other arguments
+	DataType string
+	NotNull  bool
+	IsArray  bool
+	Literal  bool
+}
+
+// spannerColumnType sets the type of a CASE, COALESCE, function call, IS TRUE
+// or JSON member access output column from the types of its arguments, when
+// they are known.
+func (c *Compiler) spannerColumnType(tables []*Table, node ast.Node, col *Column) {
+	switch n := node.(type) {
+	case *ast.CaseExpr, *ast.CoalesceExpr, *ast.FuncCall, *ast.BooleanTest:
+	case *ast.A_Indirection:
+		// doc.author.name is named after its last member, like a column
+		if col.Name == "" && n.Indirection != nil && len(n.Indirection.Items) > 0 {
+			if member, ok := n.Indirection.Items[len(n.Indirection.Items)-1].(*ast.String); ok {
+				col.Name = member.Str
+			}
+		}
+	default:
+		return
+	}
+	t := c.spannerExprType(tables, node)
+	if t == nil {
+		return
+	}
+	col.Type = nil
+	col.DataType = t.DataType
+	col.NotNull = t.NotNull
+	col.IsArray = t.IsArray
+	col.ArrayDims = 0
+	if t.IsArray {
+		col.ArrayDims = 1
+	}
+}
+
+// spannerParamTypes types a parameter compared with a function call or JSON
+// member access, such as JSON_VALUE(doc, '$.name') = @name, by the type of
+// the expression instead of the column the expression reads.
+func (c *Compiler) spannerParamTypes(qc *QueryCatalog, stmt ast.Node, refs []paramRef, params []Parameter) {
+	tables, err := c.sourceTables(qc, stmt)
+	if err != nil {
+		return
+	}
+	for _, ref := range refs {
+		expr, ok := ref.parent.(*ast.A_Expr)
+		if !ok {
+			continue
+		}
+		other := expr.Lexpr
+		if other == ref.ref {
+			other = expr.Rexpr
+		}
+		switch other.(type) {
+		case *ast.FuncCall, *ast.A_Indirection:
+		default:
+			continue
+		}
+		t := c.spannerExprType(tables, other)
+		if t == nil || t.DataType == "" {
+			continue
+		}
+		for _, p := range params {
+			if p.Number != ref.ref.Number || p.Column == nil {
+				continue
+			}
+			p.Column.Type = nil
+			p.Column.Table = nil
+			p.Column.OriginalName = ""
+			p.Column.Length = nil
+			p.Column.DataType = t.DataType
+			p.Column.IsArray = t.IsArray
+			p.Column.ArrayDims = 0
+			if t.IsArray {
+				p.Column.ArrayDims = 1
+			}
+		}
+	}
+}
+
+// spannerExprType returns the type of a Spanner expression, or nil if it
+// can't be determined.
+func (c *Compiler) spannerExprType(tables []*Table, node ast.Node) *exprType {
+	switch n := node.(type) {
+	case *ast.A_Const:
+		switch n.Val.(type) {
+		case *ast.Integer:
+			return &exprType{DataType: "int64", NotNull: true, Literal: true}
+		case *ast.Float:
+			return &exprType{DataType: "float64", NotNull: true, Literal: true}
+		case *ast.String:
+			return &exprType{DataType: "string", NotNull: true, Literal: true}
+		case *ast.Boolean:
+			return &exprType{DataType: "bool", NotNull: true, Literal: true}
+		case *ast.Null:
+			return &exprType{}
+		}
+	case *ast.Null:
+		return &exprType{}
+	case *ast.BooleanTest:
+		// IS [NOT] TRUE and IS [NOT] FALSE are never NULL
+		return &exprType{DataType: "bool", NotNull: true}
+	case *ast.ParamRef:
+		return &exprType{}
+	case *ast.ColumnRef:
+		if hasStarRef(n) {
+			return nil
+		}
+		cols, err := outputColumnRefs(&ast.ResTarget{}, tables, n)
+		if err != nil || len(cols) != 1 {
+			// Outer references of correlated subqueries and the like
+			return nil
+		}
+		return &exprType{
+			DataType: baseType(cols[0].DataType),
+			NotNull:  cols[0].NotNull,
+			IsArray:  cols[0].IsArray,
+		}
+	case *ast.TypeCast:
+		if n.TypeName == nil {
+			return nil
+		}
+		col := toColumn(n.TypeName)
+		t := &exprType{DataType: baseType(col.DataType), NotNull: true, IsArray: col.IsArray}
+		if arg := c.spannerExprType(tables, n.Arg); arg != nil && !arg.Literal {
+			t.NotNull = arg.NotNull
+		}
+		return t
+	case *ast.CaseExpr:
+		var results []*exprType
+		if n.Args != nil {
+			for _, item := range n.Args.Items {
+				when, ok := item.(*ast.CaseWhen)
+				if !ok {
+					continue
+				}
+				t := c.spannerExprType(tables, when.Result)
+				// IFNULL(expr, x) is CASE WHEN expr IS NOT NULL THEN expr ELSE x END
+				if test, ok := when.Expr.(*ast.NullTest); ok && t != nil {
+					// The Spanner engine converts IS NOT NULL to Nulltesttype 1
+					if test.Nulltesttype == 1 && test.Arg == when.Result {
+						t.NotNull = true
+					}
+				}
+				results = append(results, t)
+			}
+		}
+		if n.Defresult != nil {
+			results = append(results, c.spannerExprType(tables, n.Defresult))
+		} else {
+			results = append(results, &exprType{})
+		}
+		return supertype(results)
+	case *ast.CoalesceExpr:
+		var args []*exprType
+		notNull := false
+		for _, item := range n.Args.Items {
+			t := c.spannerExprType(tables, item)
+			if t != nil && t.NotNull {
+				notNull = true
+			}
+			args = append(args, t)
+		}
+		t := supertype(args)
+		if t != nil {
+			t.NotNull = notNull
+		}
+		return t
+	case *ast.FuncCall:
+		return c.spannerFuncType(tables, n)
+	case *ast.A_Indirection:
+		// Member and subscript access on JSON is JSON, and NULL when the
+		// member doesn't exist
+		arg := c.spannerExprType(tables, n.Arg)
+		if arg == nil || arg.DataType != "json" || arg.IsArray {
+			return nil
+		}
+		return &exprType{DataType: "json"}
+	}
+	return nil
+}
+
+func (c *Compiler) spannerFuncType(tables []*Table, call *ast.FuncCall) *exprType {
+	funs, err := c.catalog.ResolveFuncCallOverloads(call)
+	if err != nil {
+		return nil
+	}
+	var args []*exprType
+	if call.Args != nil {
+		for _, item := range call.Args.Items {
+			if _, ok := item.(*ast.NamedArgExpr); ok {
+				// Named arguments are optional and don't take part in the
+				// result type
+				break
+			}
+			args = append(args, c.spannerExprType(tables, item))
+		}
+	}
+
+	fun := funs[0]
+	if match := bestOverload(funs, args); match != nil {
+		fun = match
+	}
+	ret := fun.ReturnType.Name
+	if ret != "any" && ret != "array" {
+		t := &exprType{DataType: ret, NotNull: !fun.ReturnTypeNullable}
+		// Typed arrays, such as the ARRAY<STRING> returned by JSON_KEYS
+		if elem, ok := strings.CutPrefix(ret, "array<"); ok {
+			t.DataType = strings.TrimSuffix(elem, ">")
+			t.IsArray = true
+		}
+		// JSON functions return NULL for a NULL JSON value
+		for i, arg := range args {
+			if argType(fun, i) == "json" && arg != nil && arg.DataType != "" && !arg.NotNull {
+				t.NotNull = false
+			}
+		}
+		return t
+	}
+
+	var generic []*exprType
+	for i, arg := range args {
+		switch argType(fun, i) {
+		case "any":
+			generic = append(generic, arg)
+		case "array":
+			if arg != nil && arg.DataType != "" && !arg.IsArray {
+				return nil
+			}
+			if arg != nil {
+				arg = &exprType{DataType: arg.DataType, NotNull: arg.NotNull}
+			}
+			generic = append(generic, arg)
+		}
+	}
+	t := supertype(generic)
+	if t == nil {
+		return nil
+	}
+	t.IsArray = ret == "array"
+	t.NotNull = t.NotNull && !fun.ReturnTypeNullable
+	return t
+}
+
+// argType is the catalog type of the argument at position i
+func argType(fun *catalog.Function, i int) string {
+	args := fun.InArgs()
+	if i < len(args) {
+		return args[i].Type.Name
+	}
+	if n := len(args); n > 0 && args[n-1].Mode == ast.FuncParamVariadic {
+		return args[n-1].Type.Name
+	}
+	return ""
+}
+
+// bestOverload picks the first overload that takes the argument types as
+// they are, then the first one they can be coerced to.
+func bestOverload(funs []*catalog.Function, args []*exprType) *catalog.Function {
+	for _, coerce := range []bool{false, true} {
+		for _, fun := range funs {
+			match := true
+			for i, arg := range args {
+				if !accepts(argType(fun, i), arg, coerce) {
+					match = false
+					break
+				}
+			}
+			if match {
+				return fun
+			}
+		}
+	}
+	return nil
+}
+
+func accepts(param string, arg *exprType, coerce bool) bool {
+	switch {
+	case arg == nil || arg.DataType == "":
+		return true
+	case param == "any" || param == "":
+		return true
+	case param == "array":
+		return arg.IsArray
+	case arg.IsArray:
+		return false
+	case arg.DataType == param:
+		return true
+	case !coerce:
+		return false
+	}
+	if arg.Literal && arg.DataType == "string" {
+		switch param {
+		case "bytes", "date", "timestamp", "json", "interval":
+			return true
+		}
+	}
+	return coercible(arg.DataType, param)
+}
+
+// coercible reports whether a value of type from is implicitly converted to
+// type to.
+func coercible(from, to string) bool {
+	switch from {
+	case "int64":
+		return to == "numeric" || to == "float64"
+	case "numeric", "float32":
+		return to == "float64"
+	}
+	return false
+}
+
+// supertype returns the type all of types can be coerced to. The result is
+// nullable if any of them is.
+func supertype(types []*exprType) *exprType {
+	var typed []*exprType
+	notNull := true
+	for _, t := range types {
+		if t == nil {
+			return nil
+		}
+		notNull = notNull && t.NotNull
+		if t.DataType != "" {
+			typed = append(typed, t)
+		}
+	}
+	if len(typed) == 0 {
+		return nil
+	}
+
+	// String literals take the type of the other arguments
+	var nonLiteral []*exprType
+	for _, t := range typed {
+		if !(t.Literal && t.DataType == "string") {
+			nonLiteral = append(nonLiteral, t)
+		}
+	}
+	if len(nonLiteral) > 0 {
+		typed = nonLiteral
+	}
+
+	super := &exprType{DataType: typed[0].DataType, IsArray: typed[0].IsArray, NotNull: notNull}
+	for _, t := range typed[1:] {
+		if t.IsArray != super.IsArray {
+			return nil
+		}
+		switch {
+		case t.DataType == super.DataType:
+		case coercible(t.DataType, super.DataType):
+		case coercible(super.DataType, t.DataType):
+			super.DataType = t.DataType
+		case isNumber(t.DataType) && isNumber(super.DataType):
+			// FLOAT32 mixed with INT64, NUMERIC or FLOAT64
+			super.DataType = "float64"
+		default:
+			return nil
+		}
+	}
+	return super
+}
+
+func isNumber(dt string) bool {
+	switch dt {
+	case "int64", "numeric", "float32", "float64":
+		return true
+	}
+	return false
+}
+
+// baseType strips the length of STRING(MAX) and BYTES(1024) columns
+func baseType(dt string) string {
+	if idx := strings.Index(dt, "("); idx > 0 {
+		return dt[:idx]
+	}
+	return dt
+}
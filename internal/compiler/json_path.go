@@ -0,0 +1,87 @@
+package compiler
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+// In GoogleSQL, doc.author.name reads the "name" member of the "author"
+// member of the JSON column doc. The Spanner parser can't tell member access
+// from a qualified column reference, so both arrive as a ColumnRef.
+//
+// rewriteJSONPaths replaces the ColumnRefs that continue past a JSON column
+// of one of the tables in the query with an A_Indirection on that column, the
+// same node the parser produces for doc['author'].
+//
+// https://cloud.google.com/spanner/docs/reference/standard-sql/operators#json_field_operator
+func (c *Compiler) rewriteJSONPaths(rvs []*ast.RangeVar, raw *ast.RawStmt) {
+	// The JSON columns of each table by table name and alias, and of all the
+	// tables for unqualified references
+	tables := map[string]map[string]struct{}{}
+	columns := map[string]struct{}{}
+	for _, rv := range rvs {
+		if rv.Relname == nil {
+			continue
+		}
+		fqn, err := ParseTableName(rv)
+		if err != nil {
+			continue
+		}
+		table, err := c.catalog.GetTable(fqn)
+		if err != nil {
+			continue
+		}
+		jsonColumns := map[string]struct{}{}
+		for _, col := range table.Columns {
+			if isJSONColumn(col) {
+				jsonColumns[col.Name] = struct{}{}
+				columns[col.Name] = struct{}{}
+			}
+		}
+		tables[fqn.Name] = jsonColumns
+		if rv.Alias != nil && rv.Alias.Aliasname != nil {
+			tables[*rv.Alias.Aliasname] = jsonColumns
+		}
+	}
+	if len(columns) == 0 {
+		return
+	}
+
+	astutils.Apply(raw, func(cr *astutils.Cursor) bool {
+		ref, ok := cr.Node().(*ast.ColumnRef)
+		if !ok || hasStarRef(ref) {
+			return true
+		}
+		fields := stringSlice(ref.Fields)
+		// The column is the first field, or the second one when the first
+		// names a table. A table or alias takes precedence over a JSON column
+		// of the same name, so t.col stays a column reference.
+		n, candidates := 1, columns
+		if jsonColumns, ok := tables[fields[0]]; ok {
+			n, candidates = 2, jsonColumns
+		}
+		if len(fields) <= n {
+			return true
+		}
+		if _, ok := candidates[fields[n-1]]; !ok {
+			return true
+		}
+		members := &ast.List{}
+		for _, field := range fields[n:] {
+			members.Items = append(members.Items, &ast.String{Str: field})
+		}
+		cr.Replace(&ast.A_Indirection{
+			Arg: &ast.ColumnRef{
+				Fields:   &ast.List{Items: ref.Fields.Items[:n]},
+				Location: ref.Location,
+			},
+			Indirection: members,
+		})
+		return false
+	}, nil)
+}
+
+func isJSONColumn(col *catalog.Column) bool {
+	return !col.IsArray && col.Type.Name == "json"
+}
@@ -0,0 +1,133 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/source"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/rewrite"
+)
+
+// keyset checks a query paginated with @paginate keyset(...), and returns it
+// with the markers of internal/sql/rewrite.KeysetPagination.
+//
+// The keyset columns must be the ORDER BY clause of the query, and its output
+// columns that can't be NULL. When the tables of the query have a known
+// primary key, they must also include the primary key of one of them, so that
+// no two rows share a cursor.
+func (c *Compiler) keyset(raw *ast.RawStmt, query string, md metadata.Metadata, cols []*Column, params []Parameter) (string, *rewrite.Keyset, error) {
+	if md.Cmd != metadata.CmdMany {
+		return "", nil, fmt.Errorf("@paginate requires the :many command")
+	}
+	stmt, ok := raw.Stmt.(*ast.SelectStmt)
+	if !ok {
+		return "", nil, fmt.Errorf("@paginate requires a SELECT query")
+	}
+	for _, p := range params {
+		// The generated method takes the cursor and the limit after them
+		if name := p.Column.Name; strings.EqualFold(name, "cursor") || strings.EqualFold(name, "limit") {
+			return "", nil, fmt.Errorf("@paginate can't be used with a parameter named %s", name)
+		}
+	}
+
+	keyset, edits, err := rewrite.KeysetPagination(query, md.Keyset)
+	if err != nil {
+		return "", nil, fmt.Errorf("@paginate %w", err)
+	}
+	for _, name := range md.Keyset {
+		col := outputColumn(cols, name)
+		if col == nil {
+			return "", nil, fmt.Errorf("@paginate keyset column %q is not an output column of the query", name)
+		}
+		if !col.NotNull {
+			return "", nil, fmt.Errorf("@paginate keyset column %q can be NULL", name)
+		}
+	}
+	if err := c.checkKeysetUnique(stmt, md.Keyset, keyset.Exprs); err != nil {
+		return "", nil, err
+	}
+
+	edited, err := source.Mutate(query, edits)
+	if err != nil {
+		return "", nil, err
+	}
+	return edited, keyset, nil
+}
+
+// checkKeysetUnique checks that the sort expressions of a keyset include every
+// column of the primary key of one of the tables the query reads from.
+func (c *Compiler) checkKeysetUnique(stmt *ast.SelectStmt, names, exprs []string) error {
+	rvs := astutils.Search(stmt.FromClause, func(node ast.Node) bool {
+		_, ok := node.(*ast.RangeVar)
+		return ok
+	})
+	var first string
+	for _, item := range rvs.Items {
+		rv := item.(*ast.RangeVar)
+		if rv.Relname == nil {
+			continue
+		}
+		fqn, err := ParseTableName(rv)
+		if err != nil {
+			continue
+		}
+		table, err := c.catalog.GetTable(fqn)
+		if err != nil || len(table.PrimaryKey) == 0 {
+			// Common table expressions, unknown tables and tables without a
+			// primary key can't be checked
+			continue
+		}
+		qualifier := table.Rel.Name
+		if rv.Alias != nil && rv.Alias.Aliasname != nil {
+			qualifier = *rv.Alias.Aliasname
+		}
+		if coversKey(table.PrimaryKey, qualifier, names, exprs) {
+			return nil
+		}
+		if first == "" {
+			first = fmt.Sprintf("(%s) of table %s", strings.Join(table.PrimaryKey, ", "), table.Rel.Name)
+		}
+	}
+	if first != "" {
+		return fmt.Errorf("@paginate keyset(%s) isn't unique: it must include the primary key %s", strings.Join(names, ", "), first)
+	}
+	return nil
+}
+
+func coversKey(key []string, qualifier string, names, exprs []string) bool {
+	for _, col := range key {
+		found := false
+		for i := range names {
+			if !strings.EqualFold(names[i], col) {
+				continue
+			}
+			// An unqualified column is taken to be the column of the table
+			dot := strings.LastIndex(exprs[i], ".")
+			if dot < 0 {
+				found = true
+				continue
+			}
+			q := exprs[i][:dot]
+			q = q[strings.LastIndex(q, ".")+1:]
+			if strings.EqualFold(strings.Trim(q, "`\""), qualifier) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func outputColumn(cols []*Column, name string) *Column {
+	for _, c := range cols {
+		if strings.EqualFold(c.Name, name) {
+			return c
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/rewrite"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// orderByParams checks the sort keys of each sqlc.orderby() call against the
+// output columns of the query, and returns the parameters that choose them.
+// They come after the other parameters, as they aren't bound to the query.
+func orderByParams(orderBys []rewrite.OrderBy, cols []*Column, params []Parameter) ([]Parameter, error) {
+	number := 0
+	for _, p := range params {
+		number = max(number, p.Number)
+	}
+	var out []Parameter
+	for _, ob := range orderBys {
+		for _, p := range params {
+			if p.Column.Name == ob.Name {
+				return nil, &sqlerr.Error{
+					Message:  fmt.Sprintf("sqlc.orderby(%s) has the same name as another parameter", ob.Name),
+					Location: ob.Location,
+				}
+			}
+		}
+		for _, key := range ob.Keys {
+			if !hasOutputColumn(cols, ob.Column(key)) {
+				return nil, &sqlerr.Error{
+					Message:  fmt.Sprintf("sqlc.orderby(%s) sort key %q is not an output column of the query", ob.Name, key),
+					Location: ob.Location,
+				}
+			}
+		}
+		number++
+		out = append(out, Parameter{
+			Number: number,
+			Column: &Column{
+				Name:         ob.Name,
+				DataType:     "text",
+				NotNull:      true,
+				IsNamedParam: true,
+				OrderByKeys:  ob.Keys,
+			},
+		})
+	}
+	return out, nil
+}
+
+func hasOutputColumn(cols []*Column, name string) bool {
+	for _, c := range cols {
+		if strings.EqualFold(c.Name, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,7 +3,9 @@ package compiler
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
@@ -125,6 +127,7 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 		if !ok {
 			continue
 		}
+		prior := len(cols)
 		switch n := res.Val.(type) {
 
 		case *ast.A_Const:
@@ -388,6 +391,10 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 			cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
 
 		}
+
+		if c.conf.Engine == config.EngineSpanner && len(cols) == prior+1 {
+			c.spannerColumnType(tables, res.Val, cols[prior])
+		}
 	}
 
 	if n, ok := node.(*ast.SelectStmt); ok {
@@ -461,10 +468,18 @@ type tableVisitor struct {
 }
 
 func (r *tableVisitor) Visit(n ast.Node) astutils.Visitor {
-	switch n.(type) {
-	case *ast.RangeVar, *ast.RangeFunction:
+	switch n := n.(type) {
+	case *ast.RangeVar:
 		r.list.Items = append(r.list.Items, n)
 		return r
+	case *ast.RangeFunction:
+		r.list.Items = append(r.list.Items, n)
+		// The input relation of Spanner's ML.PREDICT is read by the model,
+		// not by the query
+		if call, ok := n.Functions.Items[0].(*ast.FuncCall); ok && isPredict(call) {
+			return nil
+		}
+		return r
 	case *ast.RangeSubselect:
 		r.list.Items = append(r.list.Items, n)
 		return nil
@@ -532,6 +547,15 @@ func (c *Compiler) sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, erro
 				return nil, fmt.Errorf("sourceTables: unsupported function call type %T", n.Functions.Items[0])
 			}
 
+			if isPredict(funcCall) {
+				table, err := c.predictTable(qc, funcCall)
+				if err != nil {
+					return nil, err
+				}
+				tables = append(tables, table)
+				continue
+			}
+
 			// If the function or table can't be found, don't error out.  There
 			// are many queries that depend on functions unknown to sqlc.
 			fn, err := qc.GetFunc(funcCall.Func)
@@ -771,3 +795,72 @@ func findColumnForRef(ref *ast.ColumnRef, tables []*Table, targetList *ast.List)
 
 	return nil
 }
+
+// Spanner's ML.PREDICT(MODEL m, input) table-valued function
+func isPredict(call *ast.FuncCall) bool {
+	return call.Func != nil && strings.EqualFold(call.Func.Name, "ML.PREDICT")
+}
+
+// predictTable returns the relation produced by ML.PREDICT: the output columns
+// of the model, followed by the columns of the input relation that the model
+// doesn't output.
+func (c *Compiler) predictTable(qc *QueryCatalog, call *ast.FuncCall) (*Table, error) {
+	if call.Args == nil || len(call.Args.Items) < 2 {
+		return nil, &sqlerr.Error{
+			Message:  "ML.PREDICT requires a MODEL and an input relation",
+			Location: call.Pos(),
+		}
+	}
+	rel, ok := call.Args.Items[0].(*ast.TableName)
+	if !ok {
+		return nil, &sqlerr.Error{
+			Message:  "the first argument of ML.PREDICT must be a MODEL",
+			Location: call.Pos(),
+		}
+	}
+	model, err := qc.catalog.GetModel(rel)
+	if err != nil {
+		return nil, err
+	}
+
+	var inputs []*Column
+	switch n := call.Args.Items[1].(type) {
+	case *ast.SubLink:
+		inputs, err = c.outputColumns(qc, n.Subselect)
+	case *ast.SelectStmt:
+		inputs, err = c.outputColumns(qc, n)
+	case *ast.RangeVar:
+		var fqn *ast.TableName
+		fqn, err = ParseTableName(n)
+		if err != nil {
+			return nil, err
+		}
+		var input *Table
+		input, err = qc.GetTable(fqn)
+		if input != nil {
+			inputs = input.Columns
+		}
+	default:
+		return nil, &sqlerr.Error{
+			Message:  "the input of ML.PREDICT must be a subquery or a TABLE",
+			Location: call.Pos(),
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	table := &Table{Rel: &ast.TableName{Name: rel.Name}}
+	outputs := map[string]struct{}{}
+	for _, col := range model.Outputs {
+		table.Columns = append(table.Columns, ConvertColumn(rel, col))
+		outputs[col.Name] = struct{}{}
+	}
+	for _, col := range inputs {
+		if _, ok := outputs[col.Name]; ok {
+			continue
+		}
+		table.Columns = append(table.Columns, col)
+	}
+	return table, nil
+}
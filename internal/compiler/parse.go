@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/debug"
+	"github.com/sqlc-dev/sqlc/internal/engine/spanner"
 	"github.com/sqlc-dev/sqlc/internal/metadata"
 	"github.com/sqlc-dev/sqlc/internal/opts"
 	"github.com/sqlc-dev/sqlc/internal/source"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/rewrite"
 	"github.com/sqlc-dev/sqlc/internal/sql/validate"
 )
 
@@ -53,6 +56,14 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err := validate.Cmd(raw.Stmt, name, cmd); err != nil {
 		return nil, err
 	}
+	if cmd == metadata.CmdPartitioned {
+		if c.conf.Engine != config.EngineSpanner {
+			return nil, fmt.Errorf("%s is only supported by Spanner", cmd)
+		}
+		if err := validate.Partitioned(raw.Stmt, spanner.IsAggregate); err != nil {
+			return nil, err
+		}
+	}
 
 	md := metadata.Metadata{
 		Name: name,
@@ -69,6 +80,10 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err != nil {
 		return nil, err
 	}
+	md.Keyset, err = metadata.ParseKeyset(cleanedComments)
+	if err != nil {
+		return nil, err
+	}
 
 	var anlys *analysis
 	if c.analyzer != nil {
@@ -108,6 +123,14 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 
 	expanded := anlys.Query
 
+	var keyset *rewrite.Keyset
+	if len(md.Keyset) > 0 {
+		expanded, keyset, err = c.keyset(raw, expanded, md, anlys.Columns, anlys.Parameters)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// If the query string was edited, make sure the syntax is valid
 	if expanded != rawSQL {
 		if _, err := c.parser.Parse(strings.NewReader(expanded)); err != nil {
@@ -122,14 +145,28 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 
 	md.Comments = comments
 
-	return &Query{
+	q := &Query{
 		RawStmt:         raw,
 		Metadata:        md,
 		Params:          anlys.Parameters,
 		Columns:         anlys.Columns,
 		SQL:             trimmed,
 		InsertIntoTable: anlys.Table,
-	}, nil
+		ForUpdate:       forUpdate(raw),
+	}
+	if keyset != nil {
+		q.KeysetExprs = keyset.Exprs
+		q.KeysetDescending = keyset.Descending
+	}
+	return q, nil
+}
+
+func forUpdate(root ast.Node) bool {
+	locks := astutils.Search(root, func(node ast.Node) bool {
+		n, ok := node.(*ast.LockingClause)
+		return ok && n.Strength == ast.LockClauseStrengthUpdate
+	})
+	return len(locks.Items) > 0
 }
 
 func rangeVars(root ast.Node) []*ast.RangeVar {
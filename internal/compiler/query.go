@@ -37,7 +37,9 @@ type Column struct {
 	Type       *ast.TypeName
 	EmbedTable *ast.TableName
 
-	IsSqlcSlice bool // is this sqlc.slice()
+	IsSqlcSlice    bool     // is this sqlc.slice()
+	IsSqlcOptional bool     // is this sqlc.optional()
+	OrderByKeys    []string // the sort keys of sqlc.orderby()
 
 	skipTableRequiredCheck bool
 }
@@ -51,6 +53,15 @@ type Query struct {
 	// Needed for CopyFrom
 	InsertIntoTable *ast.TableName
 
+	// Set when the query locks the rows it reads with FOR UPDATE, or with the
+	// LOCK_SCANNED_RANGES=exclusive hint on Spanner
+	ForUpdate bool
+
+	// The sort expressions of a query paginated with @paginate keyset(...),
+	// and whether they are in descending order
+	KeysetExprs      []string
+	KeysetDescending bool
+
 	// Needed for vet
 	RawStmt *ast.RawStmt
 }
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
+	"strings"
 
+	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
@@ -47,6 +49,9 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 			cc := c
 			typeMap[schema][table.Rel.Name][c.Name] = cc
 		}
+		for _, syn := range table.Synonyms {
+			typeMap[schema][syn] = typeMap[schema][table.Rel.Name]
+		}
 		return nil
 	}
 
@@ -167,11 +172,12 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				a = append(a, Parameter{
 					Number: ref.ref.Number,
 					Column: &Column{
-						Name:         p.Name(),
-						DataType:     dataType,
-						IsNamedParam: isNamed,
-						NotNull:      p.NotNull(),
-						IsSqlcSlice:  p.IsSqlcSlice(),
+						Name:           p.Name(),
+						DataType:       dataType,
+						IsNamedParam:   isNamed,
+						NotNull:        p.NotNull(),
+						IsSqlcSlice:    p.IsSqlcSlice(),
+						IsSqlcOptional: p.IsSqlcOptional(),
 					},
 				})
 				continue
@@ -234,17 +240,18 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 						a = append(a, Parameter{
 							Number: ref.ref.Number,
 							Column: &Column{
-								Name:         p.Name(),
-								OriginalName: c.Name,
-								DataType:     dataType(&c.Type),
-								NotNull:      p.NotNull(),
-								Unsigned:     c.IsUnsigned,
-								IsArray:      c.IsArray,
-								ArrayDims:    c.ArrayDims,
-								Length:       c.Length,
-								Table:        table,
-								IsNamedParam: isNamed,
-								IsSqlcSlice:  p.IsSqlcSlice(),
+								Name:           p.Name(),
+								OriginalName:   c.Name,
+								DataType:       dataType(&c.Type),
+								NotNull:        p.NotNull(),
+								Unsigned:       c.IsUnsigned,
+								IsArray:        c.IsArray,
+								ArrayDims:      c.ArrayDims,
+								Length:         c.Length,
+								Table:          table,
+								IsNamedParam:   isNamed,
+								IsSqlcSlice:    p.IsSqlcSlice(),
+								IsSqlcOptional: p.IsSqlcOptional(),
 							},
 						})
 					}
@@ -301,15 +308,16 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 					a = append(a, Parameter{
 						Number: ref.ref.Number,
 						Column: &Column{
-							Name:         namePrefix + p.Name(),
-							DataType:     dataType(&c.Type),
-							NotNull:      p.NotNull(),
-							Unsigned:     c.IsUnsigned,
-							IsArray:      c.IsArray,
-							ArrayDims:    c.ArrayDims,
-							Table:        table,
-							IsNamedParam: isNamed,
-							IsSqlcSlice:  p.IsSqlcSlice(),
+							Name:           namePrefix + p.Name(),
+							DataType:       dataType(&c.Type),
+							NotNull:        p.NotNull(),
+							Unsigned:       c.IsUnsigned,
+							IsArray:        c.IsArray,
+							ArrayDims:      c.ArrayDims,
+							Table:          table,
+							IsNamedParam:   isNamed,
+							IsSqlcSlice:    p.IsSqlcSlice(),
+							IsSqlcOptional: p.IsSqlcOptional(),
 						},
 					})
 				}
@@ -377,11 +385,12 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 					a = append(a, Parameter{
 						Number: ref.ref.Number,
 						Column: &Column{
-							Name:         p.Name(),
-							DataType:     "any",
-							IsNamedParam: isNamed,
-							NotNull:      p.NotNull(),
-							IsSqlcSlice:  p.IsSqlcSlice(),
+							Name:           p.Name(),
+							DataType:       "any",
+							IsNamedParam:   isNamed,
+							NotNull:        p.NotNull(),
+							IsSqlcSlice:    p.IsSqlcSlice(),
+							IsSqlcOptional: p.IsSqlcOptional(),
 						},
 					})
 					continue
@@ -419,11 +428,12 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				a = append(a, Parameter{
 					Number: ref.ref.Number,
 					Column: &Column{
-						Name:         p.Name(),
-						DataType:     dataType(paramType),
-						NotNull:      p.NotNull(),
-						IsNamedParam: isNamed,
-						IsSqlcSlice:  p.IsSqlcSlice(),
+						Name:           p.Name(),
+						DataType:       dataType(paramType),
+						NotNull:        p.NotNull(),
+						IsNamedParam:   isNamed,
+						IsSqlcSlice:    p.IsSqlcSlice(),
+						IsSqlcOptional: p.IsSqlcOptional(),
 					},
 				})
 			}
@@ -486,17 +496,18 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				a = append(a, Parameter{
 					Number: ref.ref.Number,
 					Column: &Column{
-						Name:         p.Name(),
-						OriginalName: c.Name,
-						DataType:     dataType(&c.Type),
-						NotNull:      p.NotNull(),
-						Unsigned:     c.IsUnsigned,
-						IsArray:      c.IsArray,
-						ArrayDims:    c.ArrayDims,
-						Table:        &ast.TableName{Schema: schema, Name: rel},
-						Length:       c.Length,
-						IsNamedParam: isNamed,
-						IsSqlcSlice:  p.IsSqlcSlice(),
+						Name:           p.Name(),
+						OriginalName:   c.Name,
+						DataType:       dataType(&c.Type),
+						NotNull:        p.NotNull(),
+						Unsigned:       c.IsUnsigned,
+						IsArray:        c.IsArray,
+						ArrayDims:      c.ArrayDims,
+						Table:          &ast.TableName{Schema: schema, Name: rel},
+						Length:         c.Length,
+						IsNamedParam:   isNamed,
+						IsSqlcSlice:    p.IsSqlcSlice(),
+						IsSqlcOptional: p.IsSqlcOptional(),
 					},
 				})
 			} else {
@@ -536,103 +547,130 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				number = pr.Number
 			}
 
-			location := 0
-			var key, alias string
-			var items []string
+			// (a, b) IN UNNEST(@pairs) compares each row against an array
+			// of STRUCT parameters, so every column in the row is typed as
+			// a field of @pairs.
+			exprs := []ast.Node{n.Expr}
+			row, isRow := n.Expr.(*ast.RowExpr)
+			if isRow && row.Args != nil {
+				exprs = row.Args.Items
+			}
 
-			if left, ok := n.Expr.(*ast.ColumnRef); ok {
-				location = left.Location
-				items = stringSlice(left.Fields)
-			} else if left, ok := n.Expr.(*ast.ParamRef); ok {
-				if len(n.List) <= 0 {
-					continue
-				}
-				if right, ok := n.List[0].(*ast.ColumnRef); ok {
+			for _, expr := range exprs {
+				location := 0
+				var key, alias string
+				var items []string
+
+				if left, ok := expr.(*ast.ColumnRef); ok {
 					location = left.Location
-					items = stringSlice(right.Fields)
+					items = stringSlice(left.Fields)
+				} else if left, ok := expr.(*ast.ParamRef); ok {
+					if len(n.List) <= 0 {
+						continue
+					}
+					if right, ok := n.List[0].(*ast.ColumnRef); ok {
+						location = left.Location
+						items = stringSlice(right.Fields)
+					} else {
+						continue
+					}
 				} else {
 					continue
 				}
-			} else {
-				continue
-			}
 
-			switch len(items) {
-			case 1:
-				key = items[0]
-			case 2:
-				alias = items[0]
-				key = items[1]
-			default:
-				panic("too many field items: " + strconv.Itoa(len(items)))
-			}
+				switch len(items) {
+				case 1:
+					key = items[0]
+				case 2:
+					alias = items[0]
+					key = items[1]
+				default:
+					panic("too many field items: " + strconv.Itoa(len(items)))
+				}
 
-			var found int
-			if n.Sel == nil {
-				search := tables
-				if alias != "" {
-					if original, ok := aliasMap[alias]; ok {
-						search = []*ast.TableName{original}
-					} else {
-						for _, fqn := range tables {
-							if fqn.Name == alias {
-								search = []*ast.TableName{fqn}
+				var found int
+				if n.Sel == nil {
+					search := tables
+					if alias != "" {
+						if original, ok := aliasMap[alias]; ok {
+							search = []*ast.TableName{original}
+						} else {
+							for _, fqn := range tables {
+								if fqn.Name == alias {
+									search = []*ast.TableName{fqn}
+								}
 							}
 						}
 					}
-				}
 
-				for _, table := range search {
-					schema := table.Schema
-					if schema == "" {
-						schema = c.DefaultSchema
-					}
-					if c, ok := typeMap[schema][table.Name][key]; ok {
-						found += 1
-						if ref.name != "" {
-							key = ref.name
+					for _, table := range search {
+						schema := table.Schema
+						if schema == "" {
+							schema = c.DefaultSchema
+						}
+						if c, ok := typeMap[schema][table.Name][key]; ok {
+							found += 1
+							if ref.name != "" {
+								key = ref.name
+							}
+							defaultP := named.NewInferredParam(key, c.IsNotNull)
+							p, isNamed := params.FetchMerge(ref.ref.Number, defaultP)
+							name := p.Name()
+							if isRow {
+								name = p.Name() + "." + c.Name
+							}
+							a = append(a, Parameter{
+								Number: number,
+								Column: &Column{
+									Name:           name,
+									OriginalName:   c.Name,
+									DataType:       dataType(&c.Type),
+									NotNull:        c.IsNotNull,
+									Unsigned:       c.IsUnsigned,
+									IsArray:        c.IsArray,
+									ArrayDims:      c.ArrayDims,
+									Table:          table,
+									IsNamedParam:   isNamed,
+									IsSqlcSlice:    p.IsSqlcSlice(),
+									IsSqlcOptional: p.IsSqlcOptional(),
+								},
+							})
 						}
-						defaultP := named.NewInferredParam(key, c.IsNotNull)
-						p, isNamed := params.FetchMerge(ref.ref.Number, defaultP)
-						a = append(a, Parameter{
-							Number: number,
-							Column: &Column{
-								Name:         p.Name(),
-								OriginalName: c.Name,
-								DataType:     dataType(&c.Type),
-								NotNull:      c.IsNotNull,
-								Unsigned:     c.IsUnsigned,
-								IsArray:      c.IsArray,
-								ArrayDims:    c.ArrayDims,
-								Table:        table,
-								IsNamedParam: isNamed,
-								IsSqlcSlice:  p.IsSqlcSlice(),
-							},
-						})
 					}
 				}
-			}
 
-			if found == 0 {
-				return nil, &sqlerr.Error{
-					Code:     "42703",
-					Message:  fmt.Sprintf("396: column %q does not exist", key),
-					Location: location,
+				if found == 0 {
+					return nil, &sqlerr.Error{
+						Code:     "42703",
+						Message:  fmt.Sprintf("396: column %q does not exist", key),
+						Location: location,
+					}
 				}
-			}
-			if found > 1 {
-				return nil, &sqlerr.Error{
-					Code:     "42703",
-					Message:  fmt.Sprintf("in same name column reference %q is ambiguous", key),
-					Location: location,
+				if found > 1 {
+					return nil, &sqlerr.Error{
+						Code:     "42703",
+						Message:  fmt.Sprintf("in same name column reference %q is ambiguous", key),
+						Location: location,
+					}
 				}
 			}
 
-
 		default:
 			slog.Debug("unsupported reference type", "type", fmt.Sprintf("%T", n))
 			addUnknownParam(ref)
 		}
 	}
+
+	// A Spanner STRUCT parameter is referenced by field, as in @filter.name.
+	// Each field is typed on its own and scoped to the parameter it belongs to.
+	for _, p := range a {
+		if p.Column == nil || comp.conf.QueryEngine() != config.EngineSpanner {
+			continue
+		}
+		if scope, name, ok := strings.Cut(p.Column.Name, "."); ok {
+			p.Column.Scope = scope
+			p.Column.Name = name
+		}
+	}
 	return a, nil
 }
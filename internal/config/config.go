@@ -57,6 +57,14 @@ const (
 	EngineSpanner    Engine = "spanner"
 )
 
+// Dialect is the SQL dialect of a Spanner database
+type Dialect string
+
+const (
+	DialectGoogleSQL  Dialect = "googlesql"
+	DialectPostgreSQL Dialect = "postgresql"
+)
+
 type Config struct {
 	Version   string               `json:"version" yaml:"version"`
 	Cloud     Cloud                `json:"cloud" yaml:"cloud"`
@@ -112,6 +120,8 @@ type Overrides struct {
 type SQL struct {
 	Name                 string    `json:"name" yaml:"name"`
 	Engine               Engine    `json:"engine,omitempty" yaml:"engine"`
+	Dialect              Dialect   `json:"dialect,omitempty" yaml:"dialect"`
+	DatabaseRole         string    `json:"database_role,omitempty" yaml:"database_role"`
 	Schema               Paths     `json:"schema" yaml:"schema"`
 	Queries              Paths     `json:"queries" yaml:"queries"`
 	Database             *Database `json:"database" yaml:"database"`
@@ -123,6 +133,16 @@ type SQL struct {
 	Analyzer             Analyzer  `json:"analyzer" yaml:"analyzer"`
 }
 
+// QueryEngine returns the engine whose grammar and parameter style the queries
+// are written in. Spanner databases using the PostgreSQL interface are queried
+// with PostgreSQL.
+func (s SQL) QueryEngine() Engine {
+	if s.Engine == EngineSpanner && s.Dialect == DialectPostgreSQL {
+		return EnginePostgreSQL
+	}
+	return s.Engine
+}
+
 type Analyzer struct {
 	Database *bool `json:"database" yaml:"database"`
 }
@@ -163,6 +183,8 @@ var ErrPluginBothTypes = errors.New("plugin: `process` and `wasm` cannot both be
 var ErrPluginProcessNoCmd = errors.New("plugin: missing process command")
 
 var ErrInvalidDatabase = errors.New("database must be managed or have a non-empty URI")
+var ErrInvalidDialect = errors.New("dialect must be googlesql or postgresql, and is only supported by the spanner engine")
+var ErrInvalidDatabaseRole = errors.New("database_role is only supported by the spanner engine")
 var ErrManagedDatabaseNoProject = errors.New(`managed databases require a cloud project
 
 If you don't have a project, you can create one from the sqlc Cloud
@@ -89,3 +89,21 @@ func TestInvalidConfig(t *testing.T) {
 		t.Errorf("expected err; got nil")
 	}
 }
+
+func TestInvalidDialect(t *testing.T) {
+	for _, sql := range []SQL{
+		{Engine: EnginePostgreSQL, Dialect: DialectPostgreSQL},
+		{Engine: EngineSpanner, Dialect: "mysql"},
+	} {
+		if err := Validate(&Config{SQL: []SQL{sql}}); err != ErrInvalidDialect {
+			t.Errorf("%s/%s: expected ErrInvalidDialect; got %v", sql.Engine, sql.Dialect, err)
+		}
+	}
+}
+
+func TestInvalidDatabaseRole(t *testing.T) {
+	sql := SQL{Engine: EnginePostgreSQL, DatabaseRole: "analyst"}
+	if err := Validate(&Config{SQL: []SQL{sql}}); err != ErrInvalidDatabaseRole {
+		t.Errorf("expected ErrInvalidDatabaseRole; got %v", err)
+	}
+}
@@ -7,6 +7,18 @@ func Validate(c *Config) error {
 				return ErrInvalidDatabase
 			}
 		}
+		switch sql.Dialect {
+		case "":
+		case DialectGoogleSQL, DialectPostgreSQL:
+			if sql.Engine != EngineSpanner {
+				return ErrInvalidDialect
+			}
+		default:
+			return ErrInvalidDialect
+		}
+		if sql.DatabaseRole != "" && sql.Engine != EngineSpanner {
+			return ErrInvalidDatabaseRole
+		}
 	}
 	return nil
 }
@@ -18,6 +18,7 @@ import (
 
 type CreateDatabaseRequest struct {
 	Engine     string
+	Dialect    string
 	Migrations []string
 	Prefix     string
 }
@@ -61,6 +62,8 @@ func (m *ManagedClient) CreateDatabase(ctx context.Context, req *CreateDatabaseR
 		// pass
 	case config.EnginePostgreSQL:
 		// pass
+	case config.EngineSpanner:
+		// pass
 	default:
 		return nil, fmt.Errorf("unsupported engine: %s", engine)
 	}
@@ -73,6 +76,13 @@ func (m *ManagedClient) CreateDatabase(ctx context.Context, req *CreateDatabaseR
 		}
 	}
 
+	if engine == config.EngineSpanner {
+		if strings.TrimSpace(base) == "" {
+			return nil, fmt.Errorf("no Spanner database server found")
+		}
+		return m.createSpannerDatabase(ctx, m.replacer.Replace(base), config.Dialect(req.Dialect), prefix, hash, req.Migrations)
+	}
+
 	if strings.TrimSpace(base) == "" {
 		return nil, fmt.Errorf("no PostgreSQL database server found")
 	}
@@ -0,0 +1,184 @@
+package dbmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	database "cloud.google.com/go/spanner/admin/database/apiv1"
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+	instance "cloud.google.com/go/spanner/admin/instance/apiv1"
+	"cloud.google.com/go/spanner/admin/instance/apiv1/instancepb"
+	"github.com/cloudspannerecosystem/memefish"
+	"github.com/cloudspannerecosystem/memefish/token"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+// The URI of a Spanner server is the host and port of the Spanner emulator, or
+// of another endpoint accepting plaintext connections, optionally followed by
+// the project the instances are created in:
+//
+//	localhost:9010/projects/my-project
+const defaultSpannerProject = "sqlc"
+
+func parseSpannerServer(uri string) (string, string, error) {
+	host, path, _ := strings.Cut(uri, "/")
+	if host == "" {
+		return "", "", fmt.Errorf("invalid Spanner server uri %q: missing host", uri)
+	}
+	if path == "" {
+		return host, defaultSpannerProject, nil
+	}
+	project, ok := strings.CutPrefix(path, "projects/")
+	if !ok || project == "" || strings.Contains(project, "/") {
+		return "", "", fmt.Errorf("invalid Spanner server uri %q: expected host:port/projects/<project>", uri)
+	}
+	return host, project, nil
+}
+
+// spannerIDs returns the instance and database IDs for a schema. Instance IDs
+// are at most 64 lowercase letters, digits and hyphens, and database IDs at
+// most 30 characters.
+func spannerIDs(prefix, hash string) (string, string) {
+	prefix = strings.ReplaceAll(strings.ToLower(prefix), "_", "-")
+	if n := 64 - len(hash) - 1; len(prefix) > n {
+		prefix = prefix[:n]
+	}
+	return prefix + "-" + hash, "sqlc_" + hash
+}
+
+// splitSpannerDDL splits the migrations into the individual statements
+// expected by UpdateDatabaseDdl
+func splitSpannerDDL(migrations []string) ([]string, error) {
+	var stmts []string
+	for _, m := range migrations {
+		raw, err := memefish.SplitRawStatements("", m)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range raw {
+			// Skip statements that are only whitespace and comments
+			lex := &memefish.Lexer{File: &token.File{Buffer: r.Statement}}
+			if err := lex.NextToken(); err != nil {
+				return nil, err
+			}
+			if lex.Token.Kind == token.TokenEOF {
+				continue
+			}
+			stmts = append(stmts, strings.TrimSpace(r.Statement))
+		}
+	}
+	return stmts, nil
+}
+
+// spannerCreateDatabase returns the request creating a database. PostgreSQL
+// dialect databases quote their name with double quotes instead of backticks.
+func spannerCreateDatabase(instancePath, databaseID string, dialect config.Dialect) *databasepb.CreateDatabaseRequest {
+	if dialect == config.DialectPostgreSQL {
+		return &databasepb.CreateDatabaseRequest{
+			Parent:          instancePath,
+			CreateStatement: fmt.Sprintf(`CREATE DATABASE "%s"`, databaseID),
+			DatabaseDialect: databasepb.DatabaseDialect_POSTGRESQL,
+		}
+	}
+	return &databasepb.CreateDatabaseRequest{
+		Parent:          instancePath,
+		CreateStatement: fmt.Sprintf("CREATE DATABASE `%s`", databaseID),
+		DatabaseDialect: databasepb.DatabaseDialect_GOOGLE_STANDARD_SQL,
+	}
+}
+
+func (m *ManagedClient) createSpannerDatabase(ctx context.Context, server string, dialect config.Dialect, prefix, hash string, migrations []string) (*CreateDatabaseResponse, error) {
+	host, project, err := parseSpannerServer(server)
+	if err != nil {
+		return nil, err
+	}
+	instanceID, databaseID := spannerIDs(prefix, hash)
+	instancePath := fmt.Sprintf("projects/%s/instances/%s", project, instanceID)
+	dbPath := fmt.Sprintf("%s/databases/%s", instancePath, databaseID)
+
+	// A data source name for github.com/googleapis/go-sql-spanner
+	key := fmt.Sprintf("%s/%s;usePlainText=true", host, dbPath)
+	_, err, _ = flight.Do(key, func() (interface{}, error) {
+		conn, err := grpc.NewClient(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+
+		instances, err := instance.NewInstanceAdminClient(ctx, option.WithGRPCConn(conn))
+		if err != nil {
+			return nil, err
+		}
+		defer instances.Close()
+
+		databases, err := database.NewDatabaseAdminClient(ctx, option.WithGRPCConn(conn))
+		if err != nil {
+			return nil, err
+		}
+		defer databases.Close()
+
+		if _, err := databases.GetDatabase(ctx, &databasepb.GetDatabaseRequest{Name: dbPath}); err == nil {
+			return nil, nil
+		}
+
+		_, err = instances.GetInstance(ctx, &instancepb.GetInstanceRequest{Name: instancePath})
+		if status.Code(err) == codes.NotFound {
+			op, err := instances.CreateInstance(ctx, &instancepb.CreateInstanceRequest{
+				Parent:     "projects/" + project,
+				InstanceId: instanceID,
+				Instance: &instancepb.Instance{
+					Config:      fmt.Sprintf("projects/%s/instanceConfigs/emulator-config", project),
+					DisplayName: "sqlc managed",
+					NodeCount:   1,
+				},
+			})
+			if err == nil {
+				_, err = op.Wait(ctx)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("create instance %s: %s", instanceID, err)
+			}
+		} else if err != nil {
+			return nil, fmt.Errorf("get instance %s: %s", instanceID, err)
+		}
+
+		op, err := databases.CreateDatabase(ctx, spannerCreateDatabase(instancePath, databaseID, dialect))
+		if err == nil {
+			_, err = op.Wait(ctx)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("create database %s: %s", databaseID, err)
+		}
+
+		stmts, err := splitSpannerDDL(migrations)
+		if err == nil && len(stmts) > 0 {
+			var ddl *database.UpdateDatabaseDdlOperation
+			ddl, err = databases.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
+				Database:   dbPath,
+				Statements: stmts,
+			})
+			if err == nil {
+				err = ddl.Wait(ctx)
+			}
+		}
+		if err != nil {
+			databases.DropDatabase(ctx, &databasepb.DropDatabaseRequest{Database: dbPath})
+			return nil, fmt.Errorf("%s: %s", databaseID, err)
+		}
+
+		return nil, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateDatabaseResponse{Uri: key}, nil
+}
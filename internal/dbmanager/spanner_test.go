@@ -0,0 +1,136 @@
+package dbmanager
+
+import (
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+func TestParseSpannerServer(t *testing.T) {
+	for _, tc := range []struct {
+		uri     string
+		host    string
+		project string
+		err     string
+	}{
+		{uri: "localhost:9010", host: "localhost:9010", project: "sqlc"},
+		{uri: "localhost:9010/projects/my-project", host: "localhost:9010", project: "my-project"},
+		{uri: "/projects/my-project", err: `invalid Spanner server uri "/projects/my-project": missing host`},
+		{uri: "localhost:9010/my-project", err: `invalid Spanner server uri "localhost:9010/my-project": expected host:port/projects/<project>`},
+		{uri: "localhost:9010/projects/", err: `invalid Spanner server uri "localhost:9010/projects/": expected host:port/projects/<project>`},
+		{uri: "localhost:9010/projects/p/instances/i", err: `invalid Spanner server uri "localhost:9010/projects/p/instances/i": expected host:port/projects/<project>`},
+	} {
+		t.Run(tc.uri, func(t *testing.T) {
+			host, project, err := parseSpannerServer(tc.uri)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected error %q; got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if host != tc.host || project != tc.project {
+				t.Errorf("expected %s, %s; got %s, %s", tc.host, tc.project, host, project)
+			}
+		})
+	}
+}
+
+func TestSpannerIDs(t *testing.T) {
+	for _, tc := range []struct {
+		prefix   string
+		hash     string
+		instance string
+		database string
+	}{
+		{prefix: "sqlc_managed", hash: "1a2b3c4d5e6f7a8b", instance: "sqlc-managed-1a2b3c4d5e6f7a8b", database: "sqlc_1a2b3c4d5e6f7a8b"},
+		{prefix: "Sqlc_CreateDB", hash: "ff", instance: "sqlc-createdb-ff", database: "sqlc_ff"},
+		{prefix: strings.Repeat("a", 70), hash: "1a2b3c4d5e6f7a8b", instance: strings.Repeat("a", 47) + "-1a2b3c4d5e6f7a8b", database: "sqlc_1a2b3c4d5e6f7a8b"},
+	} {
+		t.Run(tc.prefix, func(t *testing.T) {
+			instance, database := spannerIDs(tc.prefix, tc.hash)
+			if instance != tc.instance || database != tc.database {
+				t.Errorf("expected %s, %s; got %s, %s", tc.instance, tc.database, instance, database)
+			}
+			if len(instance) > 64 {
+				t.Errorf("instance ID %s is longer than 64 characters", instance)
+			}
+		})
+	}
+}
+
+func TestSplitSpannerDDL(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		migrations []string
+		stmts      []string
+	}{
+		{
+			name:       "empty",
+			migrations: []string{"", "  \n"},
+		},
+		{
+			name: "statements",
+			migrations: []string{
+				"CREATE TABLE a (id INT64) PRIMARY KEY (id);\nCREATE INDEX a_id ON a (id);",
+				"-- comment\nCREATE TABLE b (id INT64) PRIMARY KEY (id)\n",
+			},
+			stmts: []string{
+				"CREATE TABLE a (id INT64) PRIMARY KEY (id)",
+				"CREATE INDEX a_id ON a (id)",
+				"-- comment\nCREATE TABLE b (id INT64) PRIMARY KEY (id)",
+			},
+		},
+		{
+			name:       "comments only",
+			migrations: []string{"CREATE TABLE a (id INT64) PRIMARY KEY (id);\n-- done\n"},
+			stmts:      []string{"CREATE TABLE a (id INT64) PRIMARY KEY (id)"},
+		},
+		{
+			name:       "semicolon in string",
+			migrations: []string{"ALTER TABLE a ADD COLUMN s STRING(MAX) DEFAULT (';');"},
+			stmts:      []string{"ALTER TABLE a ADD COLUMN s STRING(MAX) DEFAULT (';')"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stmts, err := splitSpannerDDL(tc.migrations)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.stmts, stmts); diff != "" {
+				t.Errorf("differed (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSpannerCreateDatabase(t *testing.T) {
+	for _, tc := range []struct {
+		dialect config.Dialect
+		stmt    string
+		want    databasepb.DatabaseDialect
+	}{
+		{dialect: "", stmt: "CREATE DATABASE `sqlc_ff`", want: databasepb.DatabaseDialect_GOOGLE_STANDARD_SQL},
+		{dialect: config.DialectGoogleSQL, stmt: "CREATE DATABASE `sqlc_ff`", want: databasepb.DatabaseDialect_GOOGLE_STANDARD_SQL},
+		{dialect: config.DialectPostgreSQL, stmt: `CREATE DATABASE "sqlc_ff"`, want: databasepb.DatabaseDialect_POSTGRESQL},
+	} {
+		t.Run(string(tc.dialect), func(t *testing.T) {
+			req := spannerCreateDatabase("projects/p/instances/i", "sqlc_ff", tc.dialect)
+			if req.Parent != "projects/p/instances/i" {
+				t.Errorf("expected parent projects/p/instances/i; got %s", req.Parent)
+			}
+			if req.CreateStatement != tc.stmt {
+				t.Errorf("expected %s; got %s", tc.stmt, req.CreateStatement)
+			}
+			if req.DatabaseDialect != tc.want {
+				t.Errorf("expected %s; got %s", tc.want, req.DatabaseDialect)
+			}
+		})
+	}
+}
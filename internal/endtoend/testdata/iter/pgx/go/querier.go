@@ -0,0 +1,18 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package querytest
+
+import (
+	"context"
+	"iter"
+)
+
+type Querier interface {
+	DeleteAuthorsReturning(ctx context.Context, db DBTX, name string) iter.Seq2[DeleteAuthorsReturningRow, error]
+	IterAuthorNames(ctx context.Context, db DBTX) iter.Seq2[string, error]
+	IterAuthors(ctx context.Context, db DBTX) iter.Seq2[Author, error]
+}
+
+var _ Querier = (*Queries)(nil)
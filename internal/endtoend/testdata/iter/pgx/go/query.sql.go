@@ -0,0 +1,110 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"iter"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteAuthorsReturning = `-- name: DeleteAuthorsReturning :iter
+DELETE FROM authors
+WHERE name = $1
+RETURNING id, bio
+`
+
+type DeleteAuthorsReturningRow struct {
+	ID  int64
+	Bio pgtype.Text
+}
+
+func (q *Queries) DeleteAuthorsReturning(ctx context.Context, db DBTX, name string) iter.Seq2[DeleteAuthorsReturningRow, error] {
+	return func(yield func(DeleteAuthorsReturningRow, error) bool) {
+		rows, err := db.Query(ctx, deleteAuthorsReturning, name)
+		var zero DeleteAuthorsReturningRow
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var i DeleteAuthorsReturningRow
+			if err := rows.Scan(&i.ID, &i.Bio); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+const iterAuthorNames = `-- name: IterAuthorNames :iter
+SELECT name FROM authors
+WHERE bio IS NOT NULL
+ORDER BY name
+`
+
+func (q *Queries) IterAuthorNames(ctx context.Context, db DBTX) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		rows, err := db.Query(ctx, iterAuthorNames)
+		var zero string
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(name, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+const iterAuthors = `-- name: IterAuthors :iter
+SELECT id, name, bio FROM authors
+ORDER BY name
+`
+
+func (q *Queries) IterAuthors(ctx context.Context, db DBTX) iter.Seq2[Author, error] {
+	return func(yield func(Author, error) bool) {
+		rows, err := db.Query(ctx, iterAuthors)
+		var zero Author
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var i Author
+			if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+func New(db DBTX) *Queries {
+	return &Queries{db: db}
+}
+
+func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
+	q := Queries{db: db}
+	var err error
+	if q.deleteAuthorsReturningStmt, err = db.PrepareContext(ctx, deleteAuthorsReturning); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAuthorsReturning: %w", err)
+	}
+	if q.iterAuthorNamesStmt, err = db.PrepareContext(ctx, iterAuthorNames); err != nil {
+		return nil, fmt.Errorf("error preparing query IterAuthorNames: %w", err)
+	}
+	if q.iterAuthorsStmt, err = db.PrepareContext(ctx, iterAuthors); err != nil {
+		return nil, fmt.Errorf("error preparing query IterAuthors: %w", err)
+	}
+	return &q, nil
+}
+
+func (q *Queries) Close() error {
+	var err error
+	if q.deleteAuthorsReturningStmt != nil {
+		if cerr := q.deleteAuthorsReturningStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAuthorsReturningStmt: %w", cerr)
+		}
+	}
+	if q.iterAuthorNamesStmt != nil {
+		if cerr := q.iterAuthorNamesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing iterAuthorNamesStmt: %w", cerr)
+		}
+	}
+	if q.iterAuthorsStmt != nil {
+		if cerr := q.iterAuthorsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing iterAuthorsStmt: %w", cerr)
+		}
+	}
+	return err
+}
+
+func (q *Queries) exec(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (sql.Result, error) {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).ExecContext(ctx, args...)
+	case stmt != nil:
+		return stmt.ExecContext(ctx, args...)
+	default:
+		return q.db.ExecContext(ctx, query, args...)
+	}
+}
+
+func (q *Queries) query(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (*sql.Rows, error) {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).QueryContext(ctx, args...)
+	case stmt != nil:
+		return stmt.QueryContext(ctx, args...)
+	default:
+		return q.db.QueryContext(ctx, query, args...)
+	}
+}
+
+func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) *sql.Row {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).QueryRowContext(ctx, args...)
+	case stmt != nil:
+		return stmt.QueryRowContext(ctx, args...)
+	default:
+		return q.db.QueryRowContext(ctx, query, args...)
+	}
+}
+
+type Queries struct {
+	db                         DBTX
+	tx                         *sql.Tx
+	deleteAuthorsReturningStmt *sql.Stmt
+	iterAuthorNamesStmt        *sql.Stmt
+	iterAuthorsStmt            *sql.Stmt
+}
+
+func (q *Queries) WithTx(tx *sql.Tx) *Queries {
+	return &Queries{
+		db:                         tx,
+		tx:                         tx,
+		deleteAuthorsReturningStmt: q.deleteAuthorsReturningStmt,
+		iterAuthorNamesStmt:        q.iterAuthorNamesStmt,
+		iterAuthorsStmt:            q.iterAuthorsStmt,
+	}
+}
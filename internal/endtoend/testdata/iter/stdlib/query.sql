@@ -0,0 +1,13 @@
+-- name: IterAuthors :iter
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: IterAuthorNames :iter
+SELECT name FROM authors
+WHERE bio IS NOT NULL
+ORDER BY name;
+
+-- name: DeleteAuthorsReturning :iter
+DELETE FROM authors
+WHERE name = $1
+RETURNING id, bio;
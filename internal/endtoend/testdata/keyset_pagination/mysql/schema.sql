@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+  id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name TEXT NOT NULL
+);
+
+CREATE TABLE posts (
+  id BIGINT NOT NULL AUTO_INCREMENT,
+  author_id BIGINT NOT NULL,
+  title VARCHAR(255) NOT NULL,
+  created_at DATETIME NOT NULL,
+  PRIMARY KEY (id)
+);
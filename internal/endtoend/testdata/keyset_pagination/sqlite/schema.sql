@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+  id INTEGER PRIMARY KEY,
+  name TEXT NOT NULL
+);
+
+CREATE TABLE posts (
+  id INTEGER NOT NULL,
+  author_id INTEGER NOT NULL REFERENCES authors (id),
+  title TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  PRIMARY KEY (id)
+);
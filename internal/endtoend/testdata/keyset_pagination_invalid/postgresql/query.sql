@@ -0,0 +1,46 @@
+-- name: NotMany :one
+-- @paginate keyset(id)
+SELECT id FROM posts
+ORDER BY id;
+
+-- name: InvalidAnnotation :many
+-- @paginate offset(id)
+SELECT id FROM posts
+ORDER BY id;
+
+-- name: OrderMismatch :many
+-- @paginate keyset(created_at, id)
+SELECT id, created_at FROM posts
+ORDER BY id, created_at;
+
+-- name: MixedDirections :many
+-- @paginate keyset(created_at, id)
+SELECT id, created_at FROM posts
+ORDER BY created_at DESC, id;
+
+-- name: WithLimit :many
+-- @paginate keyset(id)
+SELECT id FROM posts
+ORDER BY id
+LIMIT 10;
+
+-- name: NotUnique :many
+-- @paginate keyset(created_at)
+SELECT id, created_at FROM posts
+ORDER BY created_at;
+
+-- name: Nullable :many
+-- @paginate keyset(published_at, id)
+SELECT id, published_at FROM posts
+ORDER BY published_at, id;
+
+-- name: NotAnOutputColumn :many
+-- @paginate keyset(created_at, id)
+SELECT id, title FROM posts
+ORDER BY created_at, id;
+
+-- name: LimitParameter :many
+-- @paginate keyset(id)
+SELECT id FROM posts
+WHERE title = sqlc.arg('limit')
+ORDER BY id;
@@ -0,0 +1,6 @@
+CREATE TABLE posts (
+  id BIGSERIAL PRIMARY KEY,
+  title TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  published_at TIMESTAMP
+);
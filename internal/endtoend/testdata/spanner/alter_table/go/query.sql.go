@@ -0,0 +1,131 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const getSinger = `-- name: GetSinger :one
+SELECT singer_id, first_name, updated_at FROM singers WHERE singer_id = @singer_id;
+`
+
+type GetSingerRow struct {
+	SingerID  int64
+	FirstName string
+	UpdatedAt sql.NullTime
+}
+
+func (q *Queries) GetSinger(ctx context.Context, singerID int64) (GetSingerRow, error) {
+	row := q.db.QueryRowContext(ctx, getSinger, sql.Named("singer_id", singerID))
+	var i GetSingerRow
+	err := row.Scan(&i.SingerID, &i.FirstName, &i.UpdatedAt)
+	return i, err
+}
+
+const listArtists = `-- name: ListArtists :many
+SELECT a.singer_id, a.first_name, a.updated_at FROM artists a ORDER BY a.first_name;
+`
+
+func (q *Queries) ListArtists(ctx context.Context) ([]Artist, error) {
+	rows, err := q.db.QueryContext(ctx, listArtists)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Artist
+	for rows.Next() {
+		var i Artist
+		if err := rows.Scan(&i.SingerID, &i.FirstName, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecords = `-- name: ListRecords :many
+SELECT singers.first_name, records.title
+FROM records
+JOIN singers ON singers.singer_id = records.singer_id;
+`
+
+type ListRecordsRow struct {
+	FirstName string
+	Title     sql.NullString
+}
+
+func (q *Queries) ListRecords(ctx context.Context) ([]ListRecordsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRecords)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecordsRow
+	for rows.Next() {
+		var i ListRecordsRow
+		if err := rows.Scan(&i.FirstName, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSongs = `-- name: ListSongs :many
+SELECT track_id, name FROM songs;
+`
+
+func (q *Queries) ListSongs(ctx context.Context) ([]Song, error) {
+	rows, err := q.db.QueryContext(ctx, listSongs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Song
+	for rows.Next() {
+		var i Song
+		if err := rows.Scan(&i.TrackID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateSingerName = `-- name: UpdateSingerName :exec
+UPDATE singers SET first_name = @first_name, updated_at = PENDING_COMMIT_TIMESTAMP()
+WHERE singer_id = @singer_id;
+`
+
+type UpdateSingerNameParams struct {
+	FirstName string
+	SingerID  int64
+}
+
+func (q *Queries) UpdateSingerName(ctx context.Context, arg UpdateSingerNameParams) error {
+	_, err := q.db.ExecContext(ctx, updateSingerName, sql.Named("first_name", arg.FirstName), sql.Named("singer_id", arg.SingerID))
+	return err
+}
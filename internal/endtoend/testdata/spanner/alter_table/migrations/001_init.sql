@@ -0,0 +1,17 @@
+CREATE TABLE singers (
+  singer_id INT64 NOT NULL,
+  first_name STRING(MAX),
+  last_name STRING(MAX)
+) PRIMARY KEY (singer_id);
+
+CREATE TABLE albums (
+  singer_id INT64 NOT NULL,
+  album_id INT64 NOT NULL,
+  title STRING(MAX)
+) PRIMARY KEY (singer_id, album_id);
+
+CREATE TABLE tracks (
+  track_id INT64 NOT NULL,
+  name STRING(MAX),
+  SYNONYM (songs)
+) PRIMARY KEY (track_id);
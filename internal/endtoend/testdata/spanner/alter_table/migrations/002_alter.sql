@@ -0,0 +1,27 @@
+ALTER TABLE singers RENAME TO artists, ADD SYNONYM singers;
+
+ALTER TABLE artists ADD COLUMN updated_at TIMESTAMP;
+
+ALTER TABLE artists ALTER COLUMN updated_at SET OPTIONS (allow_commit_timestamp = true);
+
+ALTER TABLE artists ALTER COLUMN first_name STRING(1024) NOT NULL;
+
+ALTER TABLE artists DROP COLUMN last_name;
+
+ALTER TABLE artists ADD COLUMN IF NOT EXISTS first_name STRING(MAX);
+
+ALTER TABLE albums ADD CONSTRAINT fk_albums_artists FOREIGN KEY (singer_id) REFERENCES artists (singer_id);
+
+ALTER TABLE albums SET INTERLEAVE IN PARENT artists;
+
+ALTER TABLE albums ADD ROW DELETION POLICY (OLDER_THAN(updated_at, INTERVAL 30 DAY));
+
+ALTER TABLE albums DROP CONSTRAINT fk_albums_artists;
+
+ALTER TABLE albums SET OPTIONS (locality_group = 'ssd_only');
+
+ALTER TABLE albums ALTER COLUMN title SET DEFAULT ('Untitled');
+
+ALTER TABLE tracks DROP SYNONYM songs;
+
+RENAME TABLE tracks TO songs, albums TO records;
@@ -0,0 +1,17 @@
+-- name: GetSinger :one
+SELECT * FROM singers WHERE singer_id = @singer_id;
+
+-- name: ListArtists :many
+SELECT a.singer_id, a.first_name, a.updated_at FROM artists a ORDER BY a.first_name;
+
+-- name: ListRecords :many
+SELECT singers.first_name, records.title
+FROM records
+JOIN singers ON singers.singer_id = records.singer_id;
+
+-- name: UpdateSingerName :exec
+UPDATE singers SET first_name = @first_name, updated_at = PENDING_COMMIT_TIMESTAMP()
+WHERE singer_id = @singer_id;
+
+-- name: ListSongs :many
+SELECT track_id, name FROM songs;
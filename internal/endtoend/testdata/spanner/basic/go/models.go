@@ -0,0 +1,31 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package basic
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+type Author struct {
+	ID        int64
+	Name      string
+	Bio       sql.NullString
+	CreatedAt time.Time
+	UpdatedAt sql.NullTime
+}
+
+type Book struct {
+	ID            int64
+	AuthorID      int64
+	Title         string
+	Description   sql.NullString
+	Price         sql.NullString
+	PublishedDate sql.NullTime
+	Metadata      json.RawMessage
+	Tags          []string
+	Available     bool
+}
@@ -0,0 +1,505 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package basic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+)
+
+const createAuthor = `-- name: CreateAuthor :one
+INSERT INTO authors (
+  id, name, bio, created_at
+) VALUES (
+  @id, @name, @bio, CURRENT_TIMESTAMP()
+)
+THEN RETURN id, name, bio, created_at, updated_at;
+`
+
+type CreateAuthorParams struct {
+	ID   int64
+	Name string
+	Bio  sql.NullString
+}
+
+func (q *Queries) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (Author, error) {
+	row := q.db.QueryRowContext(ctx, createAuthor, sql.Named("id", arg.ID), sql.Named("name", arg.Name), sql.Named("bio", arg.Bio))
+	var i Author
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Bio,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createBook = `-- name: CreateBook :one
+INSERT INTO books (
+  id, author_id, title, description, price, published_date, metadata, tags, available
+) VALUES (
+  @id, @author_id, @title, @description, @price, @published_date, @metadata, @tags, @available
+)
+THEN RETURN id, author_id, title, description, price, published_date, metadata, tags, available;
+`
+
+type CreateBookParams struct {
+	ID            int64
+	AuthorID      int64
+	Title         string
+	Description   sql.NullString
+	Price         sql.NullString
+	PublishedDate sql.NullTime
+	Metadata      json.RawMessage
+	Tags          []string
+	Available     bool
+}
+
+func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (Book, error) {
+	row := q.db.QueryRowContext(ctx, createBook,
+		sql.Named("id", arg.ID),
+		sql.Named("author_id", arg.AuthorID),
+		sql.Named("title", arg.Title),
+		sql.Named("description", arg.Description),
+		sql.Named("price", arg.Price),
+		sql.Named("published_date", arg.PublishedDate),
+		sql.Named("metadata", arg.Metadata),
+		sql.Named("tags", arg.Tags),
+		sql.Named("available", arg.Available),
+	)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.AuthorID,
+		&i.Title,
+		&i.Description,
+		&i.Price,
+		&i.PublishedDate,
+		&i.Metadata,
+		&i.Tags,
+		&i.Available,
+	)
+	return i, err
+}
+
+const deleteAuthor = `-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = @id;
+`
+
+func (q *Queries) DeleteAuthor(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthor, sql.Named("id", id))
+	return err
+}
+
+const getAuthor = `-- name: GetAuthor :one
+SELECT id, name, bio, created_at, updated_at FROM authors
+WHERE id = @author_id;
+`
+
+func (q *Queries) GetAuthor(ctx context.Context, authorID int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthor, sql.Named("author_id", authorID))
+	var i Author
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Bio,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAuthorBookCount = `-- name: GetAuthorBookCount :many
+SELECT 
+  a.id,
+  a.name,
+  COUNT(b.id) as book_count,
+  ARRAY_AGG(b.title ORDER BY b.published_date DESC LIMIT 5) as recent_titles
+FROM authors a
+LEFT JOIN books b ON a.id = b.author_id
+GROUP BY a.id, a.name
+ORDER BY book_count DESC;
+`
+
+type GetAuthorBookCountRow struct {
+	ID           int64
+	Name         string
+	BookCount    int64
+	RecentTitles []string
+}
+
+func (q *Queries) GetAuthorBookCount(ctx context.Context) ([]GetAuthorBookCountRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAuthorBookCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAuthorBookCountRow
+	for rows.Next() {
+		var i GetAuthorBookCountRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.BookCount,
+			&i.RecentTitles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBook = `-- name: GetBook :one
+SELECT id, author_id, title, description, price, published_date, metadata, tags, available FROM books
+WHERE id = @book_id;
+`
+
+func (q *Queries) GetBook(ctx context.Context, bookID int64) (Book, error) {
+	row := q.db.QueryRowContext(ctx, getBook, sql.Named("book_id", bookID))
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.AuthorID,
+		&i.Title,
+		&i.Description,
+		&i.Price,
+		&i.PublishedDate,
+		&i.Metadata,
+		&i.Tags,
+		&i.Available,
+	)
+	return i, err
+}
+
+const getBookStats = `-- name: GetBookStats :one
+SELECT 
+  COUNT(*) as total_books,
+  COUNT(DISTINCT author_id) as total_authors,
+  AVG(price) as avg_price,
+  MIN(published_date) as earliest_published,
+  MAX(published_date) as latest_published
+FROM books;
+`
+
+type GetBookStatsRow struct {
+	TotalBooks        int64
+	TotalAuthors      int64
+	AvgPrice          sql.NullString
+	EarliestPublished sql.NullTime
+	LatestPublished   sql.NullTime
+}
+
+func (q *Queries) GetBookStats(ctx context.Context) (GetBookStatsRow, error) {
+	row := q.db.QueryRowContext(ctx, getBookStats)
+	var i GetBookStatsRow
+	err := row.Scan(
+		&i.TotalBooks,
+		&i.TotalAuthors,
+		&i.AvgPrice,
+		&i.EarliestPublished,
+		&i.LatestPublished,
+	)
+	return i, err
+}
+
+const getBooksWithTags = `-- name: GetBooksWithTags :many
+SELECT id, author_id, title, description, price, published_date, metadata, tags, available FROM books
+WHERE ARRAY_INCLUDES(tags, @tag)
+ORDER BY title;
+`
+
+func (q *Queries) GetBooksWithTags(ctx context.Context, tag interface{}) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, getBooksWithTags, sql.Named("tag", tag))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorID,
+			&i.Title,
+			&i.Description,
+			&i.Price,
+			&i.PublishedDate,
+			&i.Metadata,
+			&i.Tags,
+			&i.Available,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecentBooks = `-- name: GetRecentBooks :many
+SELECT id, author_id, title, description, price, published_date, metadata, tags, available FROM books
+WHERE published_date >= DATE_SUB(CURRENT_DATE(), @days_ago)
+ORDER BY published_date DESC;
+`
+
+func (q *Queries) GetRecentBooks(ctx context.Context, daysAgo interface{}) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, getRecentBooks, sql.Named("days_ago", daysAgo))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorID,
+			&i.Title,
+			&i.Description,
+			&i.Price,
+			&i.PublishedDate,
+			&i.Metadata,
+			&i.Tags,
+			&i.Available,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthors = `-- name: ListAuthors :many
+SELECT id, name, bio, created_at, updated_at FROM authors
+ORDER BY name;
+`
+
+func (q *Queries) ListAuthors(ctx context.Context) ([]Author, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Bio,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooks = `-- name: ListBooks :many
+SELECT id, author_id, title, description, price, published_date, metadata, tags, available FROM books
+ORDER BY title;
+`
+
+func (q *Queries) ListBooks(ctx context.Context) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, listBooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorID,
+			&i.Title,
+			&i.Description,
+			&i.Price,
+			&i.PublishedDate,
+			&i.Metadata,
+			&i.Tags,
+			&i.Available,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooksByAuthor = `-- name: ListBooksByAuthor :many
+SELECT id, author_id, title, description, price, published_date, metadata, tags, available FROM books
+WHERE author_id = @author_id
+ORDER BY published_date DESC;
+`
+
+func (q *Queries) ListBooksByAuthor(ctx context.Context, authorID int64) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, listBooksByAuthor, sql.Named("author_id", authorID))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorID,
+			&i.Title,
+			&i.Description,
+			&i.Price,
+			&i.PublishedDate,
+			&i.Metadata,
+			&i.Tags,
+			&i.Available,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchBooks = `-- name: SearchBooks :many
+SELECT b.id, b.author_id, b.title, b.description, b.price, b.published_date, b.metadata, b.tags, b.available, a.name as author_name
+FROM books b
+JOIN authors a ON b.author_id = a.id
+WHERE LOWER(b.title) LIKE LOWER(@search_term)
+   OR LOWER(b.description) LIKE LOWER(@search_term)
+ORDER BY b.published_date DESC;
+`
+
+type SearchBooksRow struct {
+	ID            int64
+	AuthorID      int64
+	Title         string
+	Description   sql.NullString
+	Price         sql.NullString
+	PublishedDate sql.NullTime
+	Metadata      json.RawMessage
+	Tags          []string
+	Available     bool
+	AuthorName    string
+}
+
+func (q *Queries) SearchBooks(ctx context.Context, searchTerm string) ([]SearchBooksRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchBooks, sql.Named("search_term", searchTerm))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchBooksRow
+	for rows.Next() {
+		var i SearchBooksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorID,
+			&i.Title,
+			&i.Description,
+			&i.Price,
+			&i.PublishedDate,
+			&i.Metadata,
+			&i.Tags,
+			&i.Available,
+			&i.AuthorName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAuthor = `-- name: UpdateAuthor :one
+UPDATE authors
+SET name = @name,
+    bio = @bio,
+    updated_at = CURRENT_TIMESTAMP()
+WHERE id = @id
+THEN RETURN id, name, bio, created_at, updated_at;
+`
+
+type UpdateAuthorParams struct {
+	Name string
+	Bio  sql.NullString
+	ID   int64
+}
+
+func (q *Queries) UpdateAuthor(ctx context.Context, arg UpdateAuthorParams) (Author, error) {
+	row := q.db.QueryRowContext(ctx, updateAuthor, sql.Named("name", arg.Name), sql.Named("bio", arg.Bio), sql.Named("id", arg.ID))
+	var i Author
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Bio,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateBookPrice = `-- name: UpdateBookPrice :exec
+UPDATE books
+SET price = SAFE_ADD(price, @price_increase)
+WHERE id = @book_id;
+`
+
+type UpdateBookPriceParams struct {
+	PriceIncrease int64
+	BookID        int64
+}
+
+func (q *Queries) UpdateBookPrice(ctx context.Context, arg UpdateBookPriceParams) error {
+	_, err := q.db.ExecContext(ctx, updateBookPrice, sql.Named("price_increase", arg.PriceIncrease), sql.Named("book_id", arg.BookID))
+	return err
+}
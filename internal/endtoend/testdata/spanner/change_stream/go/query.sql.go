@@ -0,0 +1,79 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	spannerdriver "github.com/googleapis/go-sql-spanner"
+)
+
+const readSingersStream = `-- name: ReadSingersStream :many
+SELECT ChangeRecord FROM READ_singers_stream(
+  start_timestamp => @start_timestamp,
+  end_timestamp => @end_timestamp,
+  partition_token => @partition_token,
+  heartbeat_milliseconds => 10000
+);
+`
+
+type ReadSingersStreamParams struct {
+	StartTimestamp time.Time
+	EndTimestamp   time.Time
+	PartitionToken sql.NullString
+}
+
+func (q *Queries) ReadSingersStream(ctx context.Context, arg ReadSingersStreamParams) ([]ChangeRecords, error) {
+	rows, err := q.db.QueryContext(ctx, readSingersStream, spannerdriver.ExecOptions{DecodeOption: spannerdriver.DecodeOptionProto}, sql.Named("start_timestamp", arg.StartTimestamp), sql.Named("end_timestamp", arg.EndTimestamp), sql.Named("partition_token", arg.PartitionToken))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChangeRecords
+	for rows.Next() {
+		var ChangeRecord ChangeRecords
+		if err := rows.Scan(&ChangeRecord); err != nil {
+			return nil, err
+		}
+		items = append(items, ChangeRecord)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readSingersStreamFrom = `-- name: ReadSingersStreamFrom :many
+SELECT changerecord FROM read_Singers_Stream(@start_timestamp, NULL, NULL, 10000);
+`
+
+func (q *Queries) ReadSingersStreamFrom(ctx context.Context, startTimestamp time.Time) ([]ChangeRecords, error) {
+	rows, err := q.db.QueryContext(ctx, readSingersStreamFrom, spannerdriver.ExecOptions{DecodeOption: spannerdriver.DecodeOptionProto}, sql.Named("start_timestamp", startTimestamp))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChangeRecords
+	for rows.Next() {
+		var changerecord ChangeRecords
+		if err := rows.Scan(&changerecord); err != nil {
+			return nil, err
+		}
+		items = append(items, changerecord)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
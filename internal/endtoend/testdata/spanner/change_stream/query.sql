@@ -0,0 +1,10 @@
+-- name: ReadSingersStream :many
+SELECT ChangeRecord FROM READ_singers_stream(
+  start_timestamp => @start_timestamp,
+  end_timestamp => @end_timestamp,
+  partition_token => sqlc.narg(partition_token),
+  heartbeat_milliseconds => 10000
+);
+
+-- name: ReadSingersStreamFrom :many
+SELECT changerecord FROM read_Singers_Stream(@start_timestamp, NULL, NULL, 10000);
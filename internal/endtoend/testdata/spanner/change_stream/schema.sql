@@ -0,0 +1,6 @@
+CREATE TABLE singers (
+  singer_id INT64 NOT NULL,
+  name STRING(MAX),
+) PRIMARY KEY (singer_id);
+
+CREATE CHANGE STREAM singers_stream FOR singers;
@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: mutations.go
+
+package querytest
+
+import (
+	"cloud.google.com/go/spanner"
+)
+
+var albumMutationColumns = []string{"album_id", "title"}
+
+func albumMutationValues(arg Album) []interface{} {
+	return []interface{}{arg.AlbumID, arg.Title}
+}
+
+// InsertAlbumMutation returns a mutation that inserts arg into albums.
+func InsertAlbumMutation(arg Album) *spanner.Mutation {
+	return spanner.Insert("albums", albumMutationColumns, albumMutationValues(arg))
+}
+
+// UpdateAlbumMutation returns a mutation that updates the row of albums with the primary key of arg.
+func UpdateAlbumMutation(arg Album) *spanner.Mutation {
+	return spanner.Update("albums", albumMutationColumns, albumMutationValues(arg))
+}
+
+// InsertOrUpdateAlbumMutation returns a mutation that inserts arg into albums, or updates the row if it exists.
+func InsertOrUpdateAlbumMutation(arg Album) *spanner.Mutation {
+	return spanner.InsertOrUpdate("albums", albumMutationColumns, albumMutationValues(arg))
+}
+
+var singerMutationColumns = []string{"singer_id", "name", "created_at", "updated_at"}
+
+// Commit timestamp columns that are unset are written as spanner.CommitTimestamp.
+func singerMutationValues(arg Singer) []interface{} {
+	values := []interface{}{arg.SingerID, arg.Name, arg.CreatedAt, arg.UpdatedAt}
+	if arg.CreatedAt.IsZero() {
+		values[2] = spanner.CommitTimestamp
+	}
+	if !arg.UpdatedAt.Valid {
+		values[3] = spanner.CommitTimestamp
+	}
+	return values
+}
+
+// InsertSingerMutation returns a mutation that inserts arg into singers.
+func InsertSingerMutation(arg Singer) *spanner.Mutation {
+	return spanner.Insert("singers", singerMutationColumns, singerMutationValues(arg))
+}
+
+// UpdateSingerMutation returns a mutation that updates the row of singers with the primary key of arg.
+func UpdateSingerMutation(arg Singer) *spanner.Mutation {
+	return spanner.Update("singers", singerMutationColumns, singerMutationValues(arg))
+}
+
+// InsertOrUpdateSingerMutation returns a mutation that inserts arg into singers, or updates the row if it exists.
+func InsertOrUpdateSingerMutation(arg Singer) *spanner.Mutation {
+	return spanner.InsertOrUpdate("singers", singerMutationColumns, singerMutationValues(arg))
+}
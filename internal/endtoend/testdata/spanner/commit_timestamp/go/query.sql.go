@@ -0,0 +1,66 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createSinger = `-- name: CreateSinger :exec
+INSERT INTO singers (singer_id, name, created_at, updated_at)
+VALUES (@singer_id, @name, PENDING_COMMIT_TIMESTAMP(), PENDING_COMMIT_TIMESTAMP());
+`
+
+type CreateSingerParams struct {
+	SingerID int64
+	Name     string
+}
+
+func (q *Queries) CreateSinger(ctx context.Context, arg CreateSingerParams) error {
+	_, err := q.db.ExecContext(ctx, createSinger, sql.Named("singer_id", arg.SingerID), sql.Named("name", arg.Name))
+	return err
+}
+
+const getSinger = `-- name: GetSinger :one
+SELECT singer_id, name, created_at, updated_at FROM singers WHERE singer_id = @singer_id;
+`
+
+func (q *Queries) GetSinger(ctx context.Context, singerID int64) (Singer, error) {
+	row := q.db.QueryRowContext(ctx, getSinger, sql.Named("singer_id", singerID))
+	var i Singer
+	err := row.Scan(
+		&i.SingerID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const renameSinger = `-- name: RenameSinger :exec
+UPDATE singers SET name = @name, updated_at = PENDING_COMMIT_TIMESTAMP()
+WHERE singer_id = @singer_id;
+`
+
+type RenameSingerParams struct {
+	Name     string
+	SingerID int64
+}
+
+func (q *Queries) RenameSinger(ctx context.Context, arg RenameSingerParams) error {
+	_, err := q.db.ExecContext(ctx, renameSinger, sql.Named("name", arg.Name), sql.Named("singer_id", arg.SingerID))
+	return err
+}
+
+const touchStaleSingers = `-- name: TouchStaleSingers :exec
+UPDATE singers SET updated_at = PENDING_COMMIT_TIMESTAMP() WHERE updated_at < @cutoff;
+`
+
+func (q *Queries) TouchStaleSingers(ctx context.Context, cutoff sql.NullTime) error {
+	_, err := q.db.ExecContext(ctx, touchStaleSingers, sql.Named("cutoff", cutoff))
+	return err
+}
@@ -0,0 +1,13 @@
+-- name: CreateSinger :exec
+INSERT INTO singers (singer_id, name, created_at, updated_at)
+VALUES (@singer_id, @name, PENDING_COMMIT_TIMESTAMP(), PENDING_COMMIT_TIMESTAMP());
+
+-- name: RenameSinger :exec
+UPDATE singers SET name = @name, updated_at = PENDING_COMMIT_TIMESTAMP()
+WHERE singer_id = @singer_id;
+
+-- name: TouchStaleSingers :exec
+UPDATE singers SET updated_at = PENDING_COMMIT_TIMESTAMP() WHERE updated_at < @cutoff;
+
+-- name: GetSinger :one
+SELECT * FROM singers WHERE singer_id = @singer_id;
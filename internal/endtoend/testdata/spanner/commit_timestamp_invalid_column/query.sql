@@ -0,0 +1,5 @@
+-- name: CreateAlbum :exec
+INSERT INTO albums (album_id, title) VALUES (@album_id, @title);
+
+-- name: TouchAlbum :exec
+UPDATE albums SET title = PENDING_COMMIT_TIMESTAMP() WHERE album_id = @album_id;
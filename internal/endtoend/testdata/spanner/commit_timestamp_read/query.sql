@@ -0,0 +1,11 @@
+-- name: CopyUpdatedAt :exec
+UPDATE singers SET updated_at = PENDING_COMMIT_TIMESTAMP(), created_at = updated_at
+WHERE singer_id = @singer_id;
+
+-- name: TouchSinger :one
+UPDATE singers SET updated_at = PENDING_COMMIT_TIMESTAMP() WHERE singer_id = @singer_id
+THEN RETURN singers.updated_at;
+
+-- name: RenameSinger :exec
+UPDATE singers SET name = @name, updated_at = PENDING_COMMIT_TIMESTAMP()
+WHERE singer_id = @singer_id AND created_at < @before;
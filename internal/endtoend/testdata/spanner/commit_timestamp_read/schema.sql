@@ -0,0 +1,11 @@
+CREATE TABLE singers (
+  singer_id INT64 NOT NULL,
+  name STRING(MAX) NOT NULL,
+  created_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+  updated_at TIMESTAMP OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY (singer_id);
+
+CREATE TABLE albums (
+  album_id INT64 NOT NULL,
+  title STRING(MAX),
+) PRIMARY KEY (album_id);
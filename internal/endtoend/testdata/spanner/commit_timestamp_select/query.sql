@@ -0,0 +1,2 @@
+-- name: RecentSingers :many
+SELECT singer_id FROM singers WHERE updated_at < PENDING_COMMIT_TIMESTAMP();
@@ -0,0 +1,10 @@
+-- name: ListUserIDs :many
+SELECT id FROM users WHERE active
+UNION ALL
+SELECT u.id FROM users AS u WHERE u.name = @name
+ORDER BY id;
+
+-- name: ListInactiveUserIDs :many
+(SELECT id FROM users)
+EXCEPT DISTINCT
+(SELECT id FROM users WHERE active);
@@ -0,0 +1,26 @@
+-- name: ListOrders :many
+SELECT o.order_id, o.total, c.name, c.email
+FROM orders o
+JOIN customers c ON c.customer_id = o.customer_id
+WHERE o.status = @status;
+
+-- name: GetOrder :one
+SELECT * FROM orders WHERE order_id = @order_id;
+
+-- name: CreateOrder :exec
+INSERT INTO orders (order_id, customer_id, total, status)
+VALUES (@order_id, @customer_id, @total, @status);
+
+-- name: UpdateOrderStatus :exec
+UPDATE orders SET status = @status WHERE order_id = @order_id;
+
+-- name: DeleteOrder :exec
+DELETE FROM orders WHERE order_id = @order_id;
+
+-- name: ReadOrdersStream :many
+SELECT ChangeRecord FROM READ_orders_stream(
+  start_timestamp => @start_timestamp,
+  end_timestamp => NULL,
+  partition_token => NULL,
+  heartbeat_milliseconds => 10000
+);
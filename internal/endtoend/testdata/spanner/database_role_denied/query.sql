@@ -0,0 +1,5 @@
+-- name: GetCustomer :one
+SELECT customer_id, name, credit_card FROM customers WHERE customer_id = @customer_id;
+
+-- name: UpdateOrderTotal :exec
+UPDATE orders SET total = @total WHERE order_id = @order_id;
@@ -0,0 +1,29 @@
+CREATE TABLE customers (
+  customer_id INT64 NOT NULL,
+  name STRING(MAX) NOT NULL,
+  email STRING(MAX),
+  credit_card STRING(MAX)
+) PRIMARY KEY (customer_id);
+
+CREATE TABLE orders (
+  order_id INT64 NOT NULL,
+  customer_id INT64 NOT NULL,
+  total NUMERIC,
+  status STRING(MAX)
+) PRIMARY KEY (order_id);
+
+CREATE CHANGE STREAM orders_stream FOR orders;
+
+CREATE ROLE customer_reader;
+
+GRANT SELECT(customer_id, name, email) ON TABLE customers TO ROLE customer_reader;
+
+CREATE ROLE orders_service;
+
+GRANT ROLE customer_reader TO ROLE orders_service;
+
+GRANT SELECT, INSERT, DELETE, UPDATE(status) ON TABLE orders TO ROLE orders_service;
+
+GRANT SELECT ON CHANGE STREAM orders_stream TO ROLE orders_service;
+
+GRANT EXECUTE ON TABLE FUNCTION READ_orders_stream TO ROLE orders_service;
@@ -0,0 +1,90 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package querytest
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+type ArchiveSinger struct {
+	SingerID int64
+	Name     string
+}
+
+type Singer struct {
+	SingerID int64
+	Name     string
+	Location sql.NullString
+}
+
+// ChangeRecords is the ChangeRecord column returned by the READ_ function of a
+// change stream. Each record holds one data change, heartbeat or child
+// partitions record.
+//
+// https://cloud.google.com/spanner/docs/change-streams/details#change_streams_record_format
+type ChangeRecords []*ChangeRecord
+
+// Scan implements the Scanner interface.
+func (r *ChangeRecords) Scan(src interface{}) error {
+	v, ok := src.(spanner.GenericColumnValue)
+	if !ok {
+		return fmt.Errorf("unsupported scan type for ChangeRecords: %T", src)
+	}
+	return v.Decode((*[]*ChangeRecord)(r))
+}
+
+type ChangeRecord struct {
+	DataChangeRecord      []*DataChangeRecord      `spanner:"data_change_record" json:"data_change_record"`
+	HeartbeatRecord       []*HeartbeatRecord       `spanner:"heartbeat_record" json:"heartbeat_record"`
+	ChildPartitionsRecord []*ChildPartitionsRecord `spanner:"child_partitions_record" json:"child_partitions_record"`
+}
+
+type DataChangeRecord struct {
+	CommitTimestamp                      time.Time     `spanner:"commit_timestamp" json:"commit_timestamp"`
+	RecordSequence                       string        `spanner:"record_sequence" json:"record_sequence"`
+	ServerTransactionID                  string        `spanner:"server_transaction_id" json:"server_transaction_id"`
+	IsLastRecordInTransactionInPartition bool          `spanner:"is_last_record_in_transaction_in_partition" json:"is_last_record_in_transaction_in_partition"`
+	TableName                            string        `spanner:"table_name" json:"table_name"`
+	ColumnTypes                          []*ColumnType `spanner:"column_types" json:"column_types"`
+	Mods                                 []*Mod        `spanner:"mods" json:"mods"`
+	ModType                              string        `spanner:"mod_type" json:"mod_type"`
+	ValueCaptureType                     string        `spanner:"value_capture_type" json:"value_capture_type"`
+	NumberOfRecordsInTransaction         int64         `spanner:"number_of_records_in_transaction" json:"number_of_records_in_transaction"`
+	NumberOfPartitionsInTransaction      int64         `spanner:"number_of_partitions_in_transaction" json:"number_of_partitions_in_transaction"`
+	TransactionTag                       string        `spanner:"transaction_tag" json:"transaction_tag"`
+	IsSystemTransaction                  bool          `spanner:"is_system_transaction" json:"is_system_transaction"`
+}
+
+type ColumnType struct {
+	Name            string           `spanner:"name" json:"name"`
+	Type            spanner.NullJSON `spanner:"type" json:"type"`
+	IsPrimaryKey    bool             `spanner:"is_primary_key" json:"is_primary_key"`
+	OrdinalPosition int64            `spanner:"ordinal_position" json:"ordinal_position"`
+}
+
+type Mod struct {
+	Keys      spanner.NullJSON `spanner:"keys" json:"keys"`
+	NewValues spanner.NullJSON `spanner:"new_values" json:"new_values"`
+	OldValues spanner.NullJSON `spanner:"old_values" json:"old_values"`
+}
+
+type HeartbeatRecord struct {
+	Timestamp time.Time `spanner:"timestamp" json:"timestamp"`
+}
+
+type ChildPartitionsRecord struct {
+	StartTimestamp  time.Time         `spanner:"start_timestamp" json:"start_timestamp"`
+	RecordSequence  string            `spanner:"record_sequence" json:"record_sequence"`
+	ChildPartitions []*ChildPartition `spanner:"child_partitions" json:"child_partitions"`
+}
+
+type ChildPartition struct {
+	Token                 string   `spanner:"token" json:"token"`
+	ParentPartitionTokens []string `spanner:"parent_partition_tokens" json:"parent_partition_tokens"`
+}
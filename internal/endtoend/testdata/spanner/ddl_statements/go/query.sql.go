@@ -0,0 +1,78 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	spannerdriver "github.com/googleapis/go-sql-spanner"
+)
+
+const listSingers = `-- name: ListSingers :many
+SELECT singer_id, name FROM singers ORDER BY name;
+`
+
+type ListSingersRow struct {
+	SingerID int64
+	Name     string
+}
+
+func (q *Queries) ListSingers(ctx context.Context) ([]ListSingersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSingers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSingersRow
+	for rows.Next() {
+		var i ListSingersRow
+		if err := rows.Scan(&i.SingerID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readSingersStream = `-- name: ReadSingersStream :many
+SELECT ChangeRecord FROM READ_singers_stream(
+  start_timestamp => @start_timestamp,
+  end_timestamp => NULL,
+  partition_token => NULL,
+  heartbeat_milliseconds => 10000
+);
+`
+
+func (q *Queries) ReadSingersStream(ctx context.Context, startTimestamp time.Time) ([]ChangeRecords, error) {
+	rows, err := q.db.QueryContext(ctx, readSingersStream, spannerdriver.ExecOptions{DecodeOption: spannerdriver.DecodeOptionProto}, sql.Named("start_timestamp", startTimestamp))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChangeRecords
+	for rows.Next() {
+		var ChangeRecord ChangeRecords
+		if err := rows.Scan(&ChangeRecord); err != nil {
+			return nil, err
+		}
+		items = append(items, ChangeRecord)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,10 @@
+-- name: ListSingers :many
+SELECT singer_id, name FROM singers ORDER BY name;
+
+-- name: ReadSingersStream :many
+SELECT ChangeRecord FROM READ_singers_stream(
+  start_timestamp => @start_timestamp,
+  end_timestamp => NULL,
+  partition_token => NULL,
+  heartbeat_milliseconds => 10000
+);
@@ -0,0 +1,38 @@
+ALTER DATABASE music SET OPTIONS (version_retention_period = '7d');
+
+CREATE PLACEMENT europe OPTIONS (instance_partition = 'europe-partition');
+
+CREATE LOCALITY GROUP ssd_only OPTIONS (storage = 'ssd');
+
+CREATE SEQUENCE singer_ids OPTIONS (sequence_kind = 'bit_reversed_positive');
+
+CREATE SCHEMA archive;
+
+CREATE TABLE singers (
+  singer_id INT64 NOT NULL DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE singer_ids)),
+  name STRING(MAX) NOT NULL,
+  location STRING(MAX)
+) PRIMARY KEY (singer_id);
+
+CREATE TABLE archive.singers (
+  singer_id INT64 NOT NULL,
+  name STRING(MAX) NOT NULL
+) PRIMARY KEY (singer_id);
+
+CREATE UNIQUE INDEX singers_by_name ON singers(name DESC) STORING (location);
+
+CREATE CHANGE STREAM singers_stream FOR singers OPTIONS (retention_period = '1d');
+
+ALTER CHANGE STREAM singers_stream SET FOR singers(name);
+
+CREATE ROLE analyst;
+
+GRANT SELECT(singer_id, name) ON TABLE singers TO ROLE analyst;
+
+GRANT SELECT ON CHANGE STREAM singers_stream TO ROLE analyst;
+
+GRANT EXECUTE ON TABLE FUNCTION READ_singers_stream TO ROLE analyst;
+
+CREATE ROLE admin;
+
+GRANT ROLE analyst TO ROLE admin;
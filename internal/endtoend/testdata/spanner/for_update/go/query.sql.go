@@ -0,0 +1,91 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const getAccountForUpdate = `-- name: GetAccountForUpdate :one
+SELECT id, owner, balance FROM accounts
+WHERE id = @id
+FOR UPDATE;
+`
+
+func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getAccountForUpdate, sql.Named("id", id))
+	var i Account
+	err := row.Scan(&i.ID, &i.Owner, &i.Balance)
+	return i, err
+}
+
+const listAccountsByOwnerExclusive = `-- name: ListAccountsByOwnerExclusive :many
+@{LOCK_SCANNED_RANGES=exclusive}
+SELECT id, balance FROM accounts
+WHERE owner = @owner;
+`
+
+type ListAccountsByOwnerExclusiveRow struct {
+	ID      int64
+	Balance string
+}
+
+func (q *Queries) ListAccountsByOwnerExclusive(ctx context.Context, owner string) ([]ListAccountsByOwnerExclusiveRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByOwnerExclusive, sql.Named("owner", owner))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountsByOwnerExclusiveRow
+	for rows.Next() {
+		var i ListAccountsByOwnerExclusiveRow
+		if err := rows.Scan(&i.ID, &i.Balance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsShared = `-- name: ListAccountsShared :many
+@{LOCK_SCANNED_RANGES=shared}
+SELECT id, balance FROM accounts;
+`
+
+type ListAccountsSharedRow struct {
+	ID      int64
+	Balance string
+}
+
+func (q *Queries) ListAccountsShared(ctx context.Context) ([]ListAccountsSharedRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsShared)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountsSharedRow
+	for rows.Next() {
+		var i ListAccountsSharedRow
+		if err := rows.Scan(&i.ID, &i.Balance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,13 @@
+-- name: GetAccountForUpdate :one
+SELECT id, owner, balance FROM accounts
+WHERE id = @id
+FOR UPDATE;
+
+-- name: ListAccountsByOwnerExclusive :many
+@{LOCK_SCANNED_RANGES=exclusive}
+SELECT id, balance FROM accounts
+WHERE owner = @owner;
+
+-- name: ListAccountsShared :many
+@{LOCK_SCANNED_RANGES=shared}
+SELECT id, balance FROM accounts;
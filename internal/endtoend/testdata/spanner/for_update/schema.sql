@@ -0,0 +1,5 @@
+CREATE TABLE accounts (
+  id INT64 NOT NULL,
+  owner STRING(MAX) NOT NULL,
+  balance NUMERIC NOT NULL,
+) PRIMARY KEY (id);
@@ -0,0 +1,2 @@
+-- name: ListAlbums :many
+SELECT album_id, title FROM albums WHERE singer_id = @singer_id;
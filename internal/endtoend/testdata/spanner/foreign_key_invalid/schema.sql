@@ -0,0 +1,12 @@
+CREATE TABLE singers (
+  singer_id INT64 NOT NULL,
+  name STRING(MAX),
+) PRIMARY KEY (singer_id);
+
+CREATE TABLE albums (
+  singer_id INT64 NOT NULL,
+  album_id INT64 NOT NULL,
+  title STRING(MAX),
+) PRIMARY KEY (singer_id, album_id);
+
+ALTER TABLE albums ADD CONSTRAINT fk_albums_singers FOREIGN KEY (artist_id) REFERENCES singers (singer_id);
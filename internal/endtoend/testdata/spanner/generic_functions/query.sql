@@ -0,0 +1,27 @@
+-- name: GetProductSummary :one
+SELECT
+  COALESCE(price, 0) AS price,
+  COALESCE(qty, weight) AS qty_or_weight,
+  COALESCE(qty, 1.5) AS qty_or_default,
+  IFNULL(qty, 0) AS qty,
+  NULLIF(name, '') AS name,
+  IF(active, qty, 0) AS active_qty,
+  IF(active, price, weight) AS active_amount,
+  GREATEST(qty, weight) AS greatest,
+  LEAST(id, 10) AS least,
+  ARRAY_CONCAT(tags, extra_tags) AS all_tags,
+  ARRAY_FIRST(tags) AS first_tag,
+  JSON_VALUE(metadata, '$.color') AS color,
+  ROUND(price) AS rounded
+FROM products
+WHERE id = @id;
+
+-- name: GetProductTotals :one
+SELECT
+  ANY_VALUE(name) AS any_name,
+  ARRAY_AGG(name) AS names,
+  MAX(price) AS max_price,
+  MIN(id) AS min_id,
+  SUM(price) AS total_price,
+  SUM(qty) AS total_qty
+FROM products;
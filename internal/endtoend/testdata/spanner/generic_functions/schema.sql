@@ -0,0 +1,11 @@
+CREATE TABLE products (
+  id INT64 NOT NULL,
+  name STRING(MAX) NOT NULL,
+  price NUMERIC,
+  qty INT64,
+  weight FLOAT64,
+  active BOOL NOT NULL,
+  tags ARRAY<STRING(MAX)> NOT NULL,
+  extra_tags ARRAY<STRING(MAX)>,
+  metadata JSON,
+) PRIMARY KEY (id);
@@ -0,0 +1,76 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const listActiveUsers = `-- name: ListActiveUsers :many
+SELECT id, name FROM users
+WHERE active IS TRUE;
+`
+
+type ListActiveUsersRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) ListActiveUsers(ctx context.Context) ([]ListActiveUsersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListActiveUsersRow
+	for rows.Next() {
+		var i ListActiveUsersRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersNotInactive = `-- name: ListUsersNotInactive :many
+SELECT id, active IS NOT FALSE AS enabled FROM users
+WHERE active IS NOT TRUE OR active IS FALSE;
+`
+
+type ListUsersNotInactiveRow struct {
+	ID      int64
+	Enabled bool
+}
+
+func (q *Queries) ListUsersNotInactive(ctx context.Context) ([]ListUsersNotInactiveRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersNotInactive)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersNotInactiveRow
+	for rows.Next() {
+		var i ListUsersNotInactiveRow
+		if err := rows.Scan(&i.ID, &i.Enabled); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,7 @@
+-- name: ListActiveUsers :many
+SELECT id, name FROM users
+WHERE active IS TRUE;
+
+-- name: ListUsersNotInactive :many
+SELECT id, active IS NOT FALSE AS enabled FROM users
+WHERE active IS NOT TRUE OR active IS FALSE;
@@ -0,0 +1,5 @@
+CREATE TABLE users (
+  id INT64 NOT NULL,
+  name STRING(MAX) NOT NULL,
+  active BOOL,
+) PRIMARY KEY (id);
@@ -0,0 +1,26 @@
+-- name: GetDocumentAuthor :one
+SELECT metadata.author.name AS author_name, d.metadata.tags, metadata['title'] AS title, metadata.items[0] AS first_item, settings.theme
+FROM documents d
+WHERE id = @id;
+
+-- name: GetDocumentValues :one
+SELECT
+  JSON_VALUE(metadata, '$.title') AS title,
+  JSON_QUERY_ARRAY(metadata, '$.tags') AS tags,
+  JSON_KEYS(settings) AS setting_keys,
+  LAX_INT64(metadata.views) AS views,
+  BOOL(settings.enabled) AS enabled,
+  INT64(settings.page_size) AS page_size,
+  STRING(settings.theme) AS theme
+FROM documents
+WHERE id = @id;
+
+-- name: ListDocumentsByAuthor :many
+SELECT id FROM documents
+WHERE JSON_VALUE(metadata, '$.author.name') = @author_name
+ORDER BY LAX_INT64(metadata.rank);
+
+-- name: GetDocumentByAlias :one
+SELECT settings.id, settings.metadata.author AS author
+FROM documents settings
+WHERE settings.id = @id;
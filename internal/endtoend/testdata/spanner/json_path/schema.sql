@@ -0,0 +1,5 @@
+CREATE TABLE documents (
+  id INT64 NOT NULL,
+  metadata JSON,
+  settings JSON NOT NULL,
+) PRIMARY KEY (id);
@@ -0,0 +1,23 @@
+-- name: ListPosts :many
+-- @paginate keyset(created_at, id)
+SELECT * FROM posts
+ORDER BY created_at, id;
+
+-- name: ListPostsByAuthor :many
+-- @paginate keyset(created_at, id)
+SELECT id, title, created_at FROM posts
+WHERE author_id = sqlc.arg(author_id) OR title = sqlc.arg(title)
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListPostIDs :many
+-- @paginate keyset(id)
+SELECT id FROM posts
+WHERE title LIKE sqlc.arg(pattern)
+ORDER BY id;
+
+-- name: ListPostsWithAuthor :many
+-- @paginate keyset(title, id)
+SELECT p.id, p.title, a.name AS author
+FROM posts p
+JOIN authors a ON a.id = p.author_id
+ORDER BY p.title, p.id;
@@ -0,0 +1,11 @@
+CREATE TABLE authors (
+  id INT64 NOT NULL,
+  name STRING(MAX) NOT NULL
+) PRIMARY KEY (id);
+
+CREATE TABLE posts (
+  id INT64 NOT NULL,
+  author_id INT64 NOT NULL,
+  title STRING(MAX) NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (id);
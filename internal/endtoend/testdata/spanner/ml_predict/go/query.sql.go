@@ -0,0 +1,86 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const predictAllSentiment = `-- name: PredictAllSentiment :many
+SELECT label, score, review_id, product_id, body FROM ML.PREDICT(MODEL sentiment, TABLE reviews);
+`
+
+type PredictAllSentimentRow struct {
+	Label     string
+	Score     sql.NullFloat64
+	ReviewID  int64
+	ProductID int64
+	Body      sql.NullString
+}
+
+func (q *Queries) PredictAllSentiment(ctx context.Context) ([]PredictAllSentimentRow, error) {
+	rows, err := q.db.QueryContext(ctx, predictAllSentiment)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PredictAllSentimentRow
+	for rows.Next() {
+		var i PredictAllSentimentRow
+		if err := rows.Scan(
+			&i.Label,
+			&i.Score,
+			&i.ReviewID,
+			&i.ProductID,
+			&i.Body,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const predictReviewSentiment = `-- name: PredictReviewSentiment :many
+SELECT review_id, label, score
+FROM ML.PREDICT(MODEL sentiment, (SELECT review_id, body FROM reviews WHERE product_id = @product_id));
+`
+
+type PredictReviewSentimentRow struct {
+	ReviewID int64
+	Label    string
+	Score    sql.NullFloat64
+}
+
+func (q *Queries) PredictReviewSentiment(ctx context.Context, productID int64) ([]PredictReviewSentimentRow, error) {
+	rows, err := q.db.QueryContext(ctx, predictReviewSentiment, sql.Named("product_id", productID))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PredictReviewSentimentRow
+	for rows.Next() {
+		var i PredictReviewSentimentRow
+		if err := rows.Scan(&i.ReviewID, &i.Label, &i.Score); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
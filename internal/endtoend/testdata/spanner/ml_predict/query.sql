@@ -0,0 +1,6 @@
+-- name: PredictReviewSentiment :many
+SELECT review_id, label, score
+FROM ML.PREDICT(MODEL sentiment, (SELECT review_id, body FROM reviews WHERE product_id = @product_id));
+
+-- name: PredictAllSentiment :many
+SELECT * FROM ML.PREDICT(MODEL sentiment, TABLE reviews);
@@ -0,0 +1,12 @@
+CREATE TABLE reviews (
+  review_id INT64 NOT NULL,
+  product_id INT64 NOT NULL,
+  body STRING(MAX),
+) PRIMARY KEY (review_id);
+
+CREATE MODEL sentiment
+INPUT (body STRING(MAX))
+OUTPUT (label STRING(MAX), score FLOAT64 OPTIONS (required = false))
+REMOTE OPTIONS (
+  endpoint = '//aiplatform.googleapis.com/projects/p/locations/us-central1/endpoints/sentiment'
+);
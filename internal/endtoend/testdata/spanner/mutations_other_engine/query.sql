@@ -0,0 +1,2 @@
+-- name: GetBalance :one
+SELECT balance FROM accounts WHERE id = $1;
@@ -0,0 +1,100 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createAuthor = `-- name: CreateAuthor :exec
+INSERT INTO authors (id, name, nickname, bio)
+VALUES (@id, @name, @nickname, @bio);
+`
+
+type CreateAuthorParams struct {
+	ID       int64
+	Name     string
+	Nickname sql.NullString
+	Bio      sql.NullString
+}
+
+func (q *Queries) CreateAuthor(ctx context.Context, arg CreateAuthorParams) error {
+	_, err := q.db.ExecContext(ctx, createAuthor,
+		sql.Named("id", arg.ID),
+		sql.Named("name", arg.Name),
+		sql.Named("nickname", arg.Nickname),
+		sql.Named("bio", arg.Bio),
+	)
+	return err
+}
+
+const findAuthorsByName = `-- name: FindAuthorsByName :many
+SELECT id, name FROM authors
+WHERE name = @name OR nickname = @name;
+`
+
+type FindAuthorsByNameRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) FindAuthorsByName(ctx context.Context, name string) ([]FindAuthorsByNameRow, error) {
+	rows, err := q.db.QueryContext(ctx, findAuthorsByName, sql.Named("name", name))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindAuthorsByNameRow
+	for rows.Next() {
+		var i FindAuthorsByNameRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuthor = `-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = @id;
+`
+
+type GetAuthorRow struct {
+	ID   int64
+	Name string
+	Bio  sql.NullString
+}
+
+func (q *Queries) GetAuthor(ctx context.Context, id int64) (GetAuthorRow, error) {
+	row := q.db.QueryRowContext(ctx, getAuthor, sql.Named("id", id))
+	var i GetAuthorRow
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const updateAuthorBio = `-- name: UpdateAuthorBio :exec
+UPDATE authors SET bio = @bio
+WHERE id = @id AND name = @name;
+`
+
+type UpdateAuthorBioParams struct {
+	Bio  sql.NullString
+	ID   int64
+	Name string
+}
+
+func (q *Queries) UpdateAuthorBio(ctx context.Context, arg UpdateAuthorBioParams) error {
+	_, err := q.db.ExecContext(ctx, updateAuthorBio, sql.Named("bio", arg.Bio), sql.Named("id", arg.ID), sql.Named("name", arg.Name))
+	return err
+}
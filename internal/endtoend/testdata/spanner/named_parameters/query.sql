@@ -0,0 +1,15 @@
+-- name: FindAuthorsByName :many
+SELECT id, name FROM authors
+WHERE name = @name OR nickname = @name;
+
+-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = @id;
+
+-- name: UpdateAuthorBio :exec
+UPDATE authors SET bio = @bio
+WHERE id = @id AND name = @name;
+
+-- name: CreateAuthor :exec
+INSERT INTO authors (id, name, nickname, bio)
+VALUES (@id, @name, @nickname, @bio);
@@ -0,0 +1,6 @@
+CREATE TABLE authors (
+  id INT64 NOT NULL,
+  name STRING(MAX) NOT NULL,
+  nickname STRING(MAX),
+  bio STRING(MAX)
+) PRIMARY KEY (id);
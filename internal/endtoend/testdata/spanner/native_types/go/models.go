@@ -0,0 +1,24 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package querytest
+
+import (
+	"math/big"
+
+	"cloud.google.com/go/civil"
+	"cloud.google.com/go/spanner"
+)
+
+type Invoice struct {
+	ID       int64
+	IssuedOn civil.Date
+	PaidOn   spanner.NullDate
+	Amount   big.Rat
+	Discount spanner.NullNumeric
+	Details  spanner.NullJSON
+	Rate     float32
+	TaxRate  spanner.NullFloat32
+	DueDates []civil.Date
+}
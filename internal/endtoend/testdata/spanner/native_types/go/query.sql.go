@@ -0,0 +1,109 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+
+	"cloud.google.com/go/civil"
+	"cloud.google.com/go/spanner"
+)
+
+const getInvoice = `-- name: GetInvoice :one
+SELECT id, issued_on, paid_on, amount, discount, details, rate, tax_rate, due_dates FROM invoices WHERE id = @id;
+`
+
+func (q *Queries) GetInvoice(ctx context.Context, id int64) (Invoice, error) {
+	row := q.db.QueryRowContext(ctx, getInvoice, sql.Named("id", id))
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.IssuedOn,
+		&i.PaidOn,
+		&i.Amount,
+		&i.Discount,
+		&i.Details,
+		&i.Rate,
+		&i.TaxRate,
+		&i.DueDates,
+	)
+	return i, err
+}
+
+const getPaymentDelay = `-- name: GetPaymentDelay :one
+SELECT JUSTIFY_DAYS(INTERVAL 35 DAY) AS delay FROM invoices WHERE id = @id;
+`
+
+func (q *Queries) GetPaymentDelay(ctx context.Context, id int64) (spanner.Interval, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentDelay, sql.Named("id", id))
+	var delay spanner.Interval
+	err := row.Scan(&delay)
+	return delay, err
+}
+
+const listInvoicesIssuedOn = `-- name: ListInvoicesIssuedOn :many
+SELECT id, amount, discount FROM invoices WHERE issued_on = @issued_on;
+`
+
+type ListInvoicesIssuedOnRow struct {
+	ID       int64
+	Amount   big.Rat
+	Discount spanner.NullNumeric
+}
+
+func (q *Queries) ListInvoicesIssuedOn(ctx context.Context, issuedOn civil.Date) ([]ListInvoicesIssuedOnRow, error) {
+	rows, err := q.db.QueryContext(ctx, listInvoicesIssuedOn, sql.Named("issued_on", issuedOn))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInvoicesIssuedOnRow
+	for rows.Next() {
+		var i ListInvoicesIssuedOnRow
+		if err := rows.Scan(&i.ID, &i.Amount, &i.Discount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateInvoiceAmount = `-- name: UpdateInvoiceAmount :exec
+UPDATE invoices SET amount = @amount, paid_on = @paid_on WHERE id = @id;
+`
+
+type UpdateInvoiceAmountParams struct {
+	Amount big.Rat
+	PaidOn spanner.NullDate
+	ID     int64
+}
+
+func (q *Queries) UpdateInvoiceAmount(ctx context.Context, arg UpdateInvoiceAmountParams) error {
+	_, err := q.db.ExecContext(ctx, updateInvoiceAmount, sql.Named("amount", arg.Amount), sql.Named("paid_on", arg.PaidOn), sql.Named("id", arg.ID))
+	return err
+}
+
+const updateInvoiceDueDates = `-- name: UpdateInvoiceDueDates :exec
+UPDATE invoices SET due_dates = @due_dates WHERE id = @id;
+`
+
+type UpdateInvoiceDueDatesParams struct {
+	DueDates []civil.Date
+	ID       int64
+}
+
+func (q *Queries) UpdateInvoiceDueDates(ctx context.Context, arg UpdateInvoiceDueDatesParams) error {
+	_, err := q.db.ExecContext(ctx, updateInvoiceDueDates, sql.Named("due_dates", arg.DueDates), sql.Named("id", arg.ID))
+	return err
+}
@@ -0,0 +1,14 @@
+-- name: GetInvoice :one
+SELECT * FROM invoices WHERE id = @id;
+
+-- name: ListInvoicesIssuedOn :many
+SELECT id, amount, discount FROM invoices WHERE issued_on = @issued_on;
+
+-- name: UpdateInvoiceAmount :exec
+UPDATE invoices SET amount = @amount, paid_on = @paid_on WHERE id = @id;
+
+-- name: GetPaymentDelay :one
+SELECT JUSTIFY_DAYS(INTERVAL 35 DAY) AS delay FROM invoices WHERE id = @id;
+
+-- name: UpdateInvoiceDueDates :exec
+UPDATE invoices SET due_dates = @due_dates WHERE id = @id;
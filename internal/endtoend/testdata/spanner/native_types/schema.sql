@@ -0,0 +1,11 @@
+CREATE TABLE invoices (
+  id INT64 NOT NULL,
+  issued_on DATE NOT NULL,
+  paid_on DATE,
+  amount NUMERIC NOT NULL,
+  discount NUMERIC,
+  details JSON,
+  rate FLOAT32 NOT NULL,
+  tax_rate FLOAT32,
+  due_dates ARRAY<DATE>,
+) PRIMARY KEY (id);
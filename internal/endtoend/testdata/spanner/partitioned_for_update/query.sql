@@ -0,0 +1,7 @@
+-- name: ScanAccountsForUpdate :partitioned
+SELECT id, balance FROM accounts
+FOR UPDATE;
+
+-- name: ScanAccountsExclusive :partitioned
+@{LOCK_SCANNED_RANGES=exclusive}
+SELECT id, balance FROM accounts;
@@ -0,0 +1,20 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package querytest
+
+import (
+	"context"
+	"time"
+
+	spannerdriver "github.com/googleapis/go-sql-spanner"
+)
+
+type Querier interface {
+	ExportOrderIDs(ctx context.Context, customerID int64) (*spannerdriver.PartitionedQuery, error)
+	ExportOrders(ctx context.Context) (*spannerdriver.PartitionedQuery, error)
+	ExportOrdersSince(ctx context.Context, since time.Time) (*spannerdriver.PartitionedQuery, error)
+}
+
+var _ Querier = (*Queries)(nil)
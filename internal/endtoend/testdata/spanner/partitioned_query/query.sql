@@ -0,0 +1,8 @@
+-- name: ExportOrders :partitioned
+SELECT * FROM orders;
+
+-- name: ExportOrdersSince :partitioned
+SELECT id, total, note FROM orders WHERE created_at >= @since;
+
+-- name: ExportOrderIDs :partitioned
+SELECT id FROM orders WHERE customer_id = @customer_id;
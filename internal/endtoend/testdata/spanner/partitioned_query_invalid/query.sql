@@ -0,0 +1,17 @@
+-- name: ExportSortedOrders :partitioned
+SELECT id, total FROM orders ORDER BY created_at;
+
+-- name: ExportFirstOrders :partitioned
+SELECT id, total FROM orders LIMIT 10;
+
+-- name: ExportCustomerTotals :partitioned
+SELECT customer_id, SUM(total) AS total FROM orders GROUP BY customer_id;
+
+-- name: CountOrders :partitioned
+SELECT COUNT(*) FROM orders;
+
+-- name: DeleteOrders :partitioned
+DELETE FROM orders WHERE true;
+
+-- name: ListCustomers :partitioned
+SELECT DISTINCT customer_id FROM orders;
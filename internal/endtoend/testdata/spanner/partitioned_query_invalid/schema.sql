@@ -0,0 +1,7 @@
+CREATE TABLE orders (
+  id INT64 NOT NULL,
+  customer_id INT64 NOT NULL,
+  total NUMERIC NOT NULL,
+  note STRING(MAX),
+  created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (id);
@@ -0,0 +1,106 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+)
+
+const createSinger = `-- name: CreateSinger :exec
+INSERT INTO singers (singer_id, name, metadata, updated_at)
+VALUES ($1, $2, $3, spanner.pending_commit_timestamp())
+`
+
+type CreateSingerParams struct {
+	SingerID int64
+	Name     string
+	Metadata spanner.PGJsonB
+}
+
+func (q *Queries) CreateSinger(ctx context.Context, arg CreateSingerParams) error {
+	_, err := q.db.ExecContext(ctx, createSinger, arg.SingerID, arg.Name, arg.Metadata)
+	return err
+}
+
+const getSinger = `-- name: GetSinger :one
+SELECT singer_id, name, metadata, revenue, genres, updated_at FROM singers
+WHERE singer_id = $1
+`
+
+func (q *Queries) GetSinger(ctx context.Context, singerID int64) (Singer, error) {
+	row := q.db.QueryRowContext(ctx, getSinger, singerID)
+	var i Singer
+	err := row.Scan(
+		&i.SingerID,
+		&i.Name,
+		&i.Metadata,
+		&i.Revenue,
+		&i.Genres,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listSingersByName = `-- name: ListSingersByName :many
+SELECT singer_id, metadata FROM singers
+WHERE name = $1
+`
+
+type ListSingersByNameRow struct {
+	SingerID int64
+	Metadata spanner.PGJsonB
+}
+
+func (q *Queries) ListSingersByName(ctx context.Context, name string) ([]ListSingersByNameRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSingersByName, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSingersByNameRow
+	for rows.Next() {
+		var i ListSingersByNameRow
+		if err := rows.Scan(&i.SingerID, &i.Metadata); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const singerFingerprint = `-- name: SingerFingerprint :one
+SELECT spanner.farm_fingerprint(name) AS fingerprint FROM singers
+WHERE singer_id = $1
+`
+
+func (q *Queries) SingerFingerprint(ctx context.Context, singerID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, singerFingerprint, singerID)
+	var fingerprint int64
+	err := row.Scan(&fingerprint)
+	return fingerprint, err
+}
+
+const updateSingerGenres = `-- name: UpdateSingerGenres :exec
+UPDATE singers SET genres = $1 WHERE singer_id = $2
+`
+
+type UpdateSingerGenresParams struct {
+	Genres   []string
+	SingerID int64
+}
+
+func (q *Queries) UpdateSingerGenres(ctx context.Context, arg UpdateSingerGenresParams) error {
+	_, err := q.db.ExecContext(ctx, updateSingerGenres, arg.Genres, arg.SingerID)
+	return err
+}
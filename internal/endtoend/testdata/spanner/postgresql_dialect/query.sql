@@ -0,0 +1,18 @@
+-- name: GetSinger :one
+SELECT * FROM singers
+WHERE singer_id = $1;
+
+-- name: ListSingersByName :many
+SELECT singer_id, metadata FROM singers
+WHERE name = @name;
+
+-- name: CreateSinger :exec
+INSERT INTO singers (singer_id, name, metadata, updated_at)
+VALUES ($1, $2, $3, spanner.pending_commit_timestamp());
+
+-- name: SingerFingerprint :one
+SELECT spanner.farm_fingerprint(name) AS fingerprint FROM singers
+WHERE singer_id = $1;
+
+-- name: UpdateSingerGenres :exec
+UPDATE singers SET genres = $1 WHERE singer_id = $2;
@@ -0,0 +1,8 @@
+CREATE TABLE singers (
+  singer_id bigint NOT NULL PRIMARY KEY,
+  name varchar(1024) NOT NULL,
+  metadata jsonb,
+  revenue numeric,
+  genres varchar[],
+  updated_at spanner.commit_timestamp NOT NULL
+);
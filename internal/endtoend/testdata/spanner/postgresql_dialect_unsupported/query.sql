@@ -0,0 +1,3 @@
+-- name: GetSinger :one
+SELECT * FROM singers
+WHERE singer_id = $1;
@@ -0,0 +1,4 @@
+CREATE TABLE singers (
+  singer_id bigserial NOT NULL PRIMARY KEY,
+  name varchar(1024) NOT NULL
+);
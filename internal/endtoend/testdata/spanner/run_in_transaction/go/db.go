@@ -0,0 +1,48 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	spannerdriver "github.com/googleapis/go-sql-spanner"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+func New(db DBTX) *Queries {
+	return &Queries{db: db}
+}
+
+type Queries struct {
+	db DBTX
+}
+
+func (q *Queries) WithTx(tx *sql.Tx) *Queries {
+	return &Queries{
+		db: tx,
+	}
+}
+
+// RunInTransaction runs f in a read-write transaction. Spanner aborts
+// transactions that conflict with others, and f is then run again with a new
+// transaction, so it must be safe to run more than once. The Queries must have
+// been created with a *sql.DB opened with github.com/googleapis/go-sql-spanner.
+func (q *Queries) RunInTransaction(ctx context.Context, f func(*Queries) error) error {
+	db, ok := q.db.(*sql.DB)
+	if !ok {
+		return errors.New("RunInTransaction requires Queries created with a *sql.DB")
+	}
+	return spannerdriver.RunTransaction(ctx, db, nil, func(ctx context.Context, tx *sql.Tx) error {
+		return f(q.WithTx(tx))
+	})
+}
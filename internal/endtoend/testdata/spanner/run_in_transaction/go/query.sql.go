@@ -0,0 +1,36 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const getBalance = `-- name: GetBalance :one
+SELECT balance FROM accounts WHERE id = @id;
+`
+
+func (q *Queries) GetBalance(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getBalance, sql.Named("id", id))
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
+const updateBalance = `-- name: UpdateBalance :exec
+UPDATE accounts SET balance = @balance WHERE id = @id;
+`
+
+type UpdateBalanceParams struct {
+	Balance int64
+	ID      int64
+}
+
+func (q *Queries) UpdateBalance(ctx context.Context, arg UpdateBalanceParams) error {
+	_, err := q.db.ExecContext(ctx, updateBalance, sql.Named("balance", arg.Balance), sql.Named("id", arg.ID))
+	return err
+}
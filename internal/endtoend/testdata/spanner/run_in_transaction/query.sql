@@ -0,0 +1,5 @@
+-- name: GetBalance :one
+SELECT balance FROM accounts WHERE id = @id;
+
+-- name: UpdateBalance :exec
+UPDATE accounts SET balance = @balance WHERE id = @id;
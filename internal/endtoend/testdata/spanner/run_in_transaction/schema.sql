@@ -0,0 +1,4 @@
+CREATE TABLE accounts (
+  id INT64 NOT NULL,
+  balance INT64 NOT NULL,
+) PRIMARY KEY (id);
@@ -1,20 +1,39 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.29.0
+//   sqlc v1.30.0
 // source: query.sql
 
 package simple
 
 import (
 	"context"
+	"database/sql"
 )
 
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (id, name, email) VALUES (@id, @name, @email)
+THEN RETURN id, name, email;
+`
+
+type CreateUserParams struct {
+	ID    int64
+	Name  string
+	Email sql.NullString
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, createUser, sql.Named("id", arg.ID), sql.Named("name", arg.Name), sql.Named("email", arg.Email))
+	var i User
+	err := row.Scan(&i.ID, &i.Name, &i.Email)
+	return i, err
+}
+
 const getUser = `-- name: GetUser :one
 SELECT id, name, email FROM users WHERE id = @user_id;
 `
 
-func (q *Queries) GetUser(ctx context.Context, id int64) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUser, id)
+func (q *Queries) GetUser(ctx context.Context, userID int64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUser, sql.Named("user_id", userID))
 	var i User
 	err := row.Scan(&i.ID, &i.Name, &i.Email)
 	return i, err
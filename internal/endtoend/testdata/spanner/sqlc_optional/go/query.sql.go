@@ -0,0 +1,87 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+const countUsers = `-- name: CountUsers :one
+SELECT count(*) FROM users
+WHERE /*OPTIONAL:prefix*/STARTS_WITH(name, @prefix)/*END*/;
+`
+
+func (q *Queries) CountUsers(ctx context.Context, prefix *string) (int64, error) {
+	query := countUsers
+	var queryParams []interface{}
+	if prefix != nil {
+		queryParams = append(queryParams, sql.Named("prefix", *prefix))
+	} else {
+		query = strings.Replace(query, "/*OPTIONAL:prefix*/STARTS_WITH(name, @prefix)/*END*/", "TRUE", 1)
+	}
+	row := q.db.QueryRowContext(ctx, query, queryParams...)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, name FROM users
+WHERE status = @status
+  AND /*OPTIONAL:name*/name = @name/*END*/
+  AND /*OPTIONAL:min_age*/age >= @min_age/*END*/
+ORDER BY id
+LIMIT 100;
+`
+
+type ListUsersParams struct {
+	Status string
+	Name   *string
+	MinAge *int64
+}
+
+type ListUsersRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]ListUsersRow, error) {
+	query := listUsers
+	var queryParams []interface{}
+	queryParams = append(queryParams, sql.Named("status", arg.Status))
+	if arg.Name != nil {
+		queryParams = append(queryParams, sql.Named("name", *arg.Name))
+	} else {
+		query = strings.Replace(query, "/*OPTIONAL:name*/name = @name/*END*/", "TRUE", 1)
+	}
+	if arg.MinAge != nil {
+		queryParams = append(queryParams, sql.Named("min_age", *arg.MinAge))
+	} else {
+		query = strings.Replace(query, "/*OPTIONAL:min_age*/age >= @min_age/*END*/", "TRUE", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersRow
+	for rows.Next() {
+		var i ListUsersRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
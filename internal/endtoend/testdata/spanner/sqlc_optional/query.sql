@@ -0,0 +1,11 @@
+-- name: ListUsers :many
+SELECT id, name FROM users
+WHERE status = @status
+  AND name = sqlc.optional('name')
+  AND age >= sqlc.optional('min_age')
+ORDER BY id
+LIMIT 100;
+
+-- name: CountUsers :one
+SELECT count(*) FROM users
+WHERE STARTS_WITH(name, sqlc.optional('prefix'));
@@ -0,0 +1,14 @@
+-- name: ListUsers :many
+SELECT id, name, age FROM users
+WHERE status = @status
+ORDER BY sqlc.orderby('sort', 'name', 'age DESC', 'age'), id
+LIMIT 100;
+
+-- name: ListAllUsers :many
+SELECT id, name FROM users
+ORDER BY sqlc.orderby('sort', 'name', 'id');
+
+-- name: SearchUsers :many
+SELECT id, name FROM users
+WHERE STARTS_WITH(name, sqlc.optional('prefix'))
+ORDER BY sqlc.orderby('sort', 'name', 'id DESC');
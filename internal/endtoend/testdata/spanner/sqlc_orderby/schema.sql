@@ -0,0 +1,6 @@
+CREATE TABLE users (
+  id INT64 NOT NULL,
+  name STRING(MAX) NOT NULL,
+  status STRING(MAX) NOT NULL,
+  age INT64
+) PRIMARY KEY (id);
@@ -0,0 +1,21 @@
+-- name: FuncParamIdent :many
+SELECT name FROM foo
+WHERE name = sqlc.arg(slug)
+  AND id IN (sqlc.slice(favourites));
+
+-- name: FuncParamString :many
+SELECT name FROM foo
+WHERE name = sqlc.arg('slug')
+  AND id IN (sqlc.slice('favourites'));
+
+-- name: SliceInUnnest :many
+SELECT name FROM foo
+WHERE id IN UNNEST(sqlc.slice('ids'));
+
+-- name: UnnestParam :many
+SELECT id FROM foo
+WHERE name IN UNNEST(@names);
+
+-- name: NotInUnnestParam :many
+SELECT id FROM foo
+WHERE bar NOT IN UNNEST(@bars) AND name = @name;
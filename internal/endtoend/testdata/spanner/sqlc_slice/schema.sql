@@ -0,0 +1,5 @@
+CREATE TABLE foo (
+  id INT64 NOT NULL,
+  name STRING(MAX) NOT NULL,
+  bar STRING(MAX)
+) PRIMARY KEY (id);
@@ -0,0 +1,114 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const findSingers = `-- name: FindSingers :many
+SELECT singer_id FROM singers
+WHERE first_name = @filter.first_name AND last_name = @filter.last_name;
+`
+
+type FindSingersFilter struct {
+	FirstName string `spanner:"first_name"`
+	LastName  string `spanner:"last_name"`
+}
+
+func (q *Queries) FindSingers(ctx context.Context, filter FindSingersFilter) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, findSingers, sql.Named("filter", filter))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var singer_id int64
+		if err := rows.Scan(&singer_id); err != nil {
+			return nil, err
+		}
+		items = append(items, singer_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findSingersBornAfter = `-- name: FindSingersBornAfter :many
+SELECT singer_id FROM singers
+WHERE first_name = @filter.first_name AND birth_date > @born_after;
+`
+
+type FindSingersBornAfterFilter struct {
+	FirstName string `spanner:"first_name"`
+}
+
+type FindSingersBornAfterParams struct {
+	Filter    FindSingersBornAfterFilter
+	BornAfter sql.NullTime
+}
+
+func (q *Queries) FindSingersBornAfter(ctx context.Context, arg FindSingersBornAfterParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, findSingersBornAfter, sql.Named("filter", arg.Filter), sql.Named("born_after", arg.BornAfter))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var singer_id int64
+		if err := rows.Scan(&singer_id); err != nil {
+			return nil, err
+		}
+		items = append(items, singer_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAlbumsByKeys = `-- name: GetAlbumsByKeys :many
+SELECT singer_id, album_id, title FROM albums
+WHERE (singer_id, album_id) IN UNNEST(@keys);
+`
+
+type GetAlbumsByKeysKeys struct {
+	SingerID int64 `spanner:"singer_id"`
+	AlbumID  int64 `spanner:"album_id"`
+}
+
+func (q *Queries) GetAlbumsByKeys(ctx context.Context, keys []GetAlbumsByKeysKeys) ([]Album, error) {
+	rows, err := q.db.QueryContext(ctx, getAlbumsByKeys, sql.Named("keys", keys))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Album
+	for rows.Next() {
+		var i Album
+		if err := rows.Scan(&i.SingerID, &i.AlbumID, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
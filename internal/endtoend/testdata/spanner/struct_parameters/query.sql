@@ -0,0 +1,11 @@
+-- name: FindSingers :many
+SELECT singer_id FROM singers
+WHERE first_name = @filter.first_name AND last_name = @filter.last_name;
+
+-- name: FindSingersBornAfter :many
+SELECT singer_id FROM singers
+WHERE first_name = @filter.first_name AND birth_date > @born_after;
+
+-- name: GetAlbumsByKeys :many
+SELECT singer_id, album_id, title FROM albums
+WHERE (singer_id, album_id) IN UNNEST(@keys);
@@ -0,0 +1,13 @@
+CREATE TABLE singers (
+  singer_id INT64 NOT NULL,
+  first_name STRING(1024) NOT NULL,
+  last_name STRING(1024) NOT NULL,
+  birth_date DATE
+) PRIMARY KEY (singer_id);
+
+CREATE TABLE albums (
+  singer_id INT64 NOT NULL,
+  album_id INT64 NOT NULL,
+  title STRING(MAX) NOT NULL
+) PRIMARY KEY (singer_id, album_id),
+  INTERLEAVE IN PARENT singers ON DELETE CASCADE;
@@ -0,0 +1,3 @@
+-- name: ListUserNames :many
+SELECT id, name FROM users
+|> WHERE id > 10;
@@ -0,0 +1,6 @@
+-- name: GetUser :one
+SELECT id, name FROM users WHERE id = @id;
+
+-- name: ListUsers :many
+SELECT id, name FROM users
+WHERE id IN (1, 2,);
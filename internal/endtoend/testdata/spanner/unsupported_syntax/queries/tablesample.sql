@@ -0,0 +1,2 @@
+-- name: SampleUsers :many
+SELECT id FROM (SELECT id FROM users) TABLESAMPLE BERNOULLI (10 PERCENT);
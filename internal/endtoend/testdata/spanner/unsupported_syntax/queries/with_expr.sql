@@ -0,0 +1,2 @@
+-- name: ListDoubledNames :many
+SELECT WITH(n AS name, CONCAT(n, n)) AS doubled FROM users;
@@ -0,0 +1,58 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const getSingerRevenue = `-- name: GetSingerRevenue :one
+SELECT n.first_name, n.last_name, r.album_count
+FROM singer_names n
+JOIN album_revenue r ON r.singer_id = n.singer_id
+WHERE n.singer_id = @singer_id;
+`
+
+type GetSingerRevenueRow struct {
+	FirstName  sql.NullString
+	LastName   string
+	AlbumCount int64
+}
+
+func (q *Queries) GetSingerRevenue(ctx context.Context, singerID int64) (GetSingerRevenueRow, error) {
+	row := q.db.QueryRowContext(ctx, getSingerRevenue, sql.Named("singer_id", singerID))
+	var i GetSingerRevenueRow
+	err := row.Scan(&i.FirstName, &i.LastName, &i.AlbumCount)
+	return i, err
+}
+
+const listSingerNames = `-- name: ListSingerNames :many
+SELECT singer_id, first_name, last_name FROM singer_names ORDER BY last_name;
+`
+
+func (q *Queries) ListSingerNames(ctx context.Context) ([]SingerName, error) {
+	rows, err := q.db.QueryContext(ctx, listSingerNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SingerName
+	for rows.Next() {
+		var i SingerName
+		if err := rows.Scan(&i.SingerID, &i.FirstName, &i.LastName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,8 @@
+-- name: ListSingerNames :many
+SELECT * FROM singer_names ORDER BY last_name;
+
+-- name: GetSingerRevenue :one
+SELECT n.first_name, n.last_name, r.album_count
+FROM singer_names n
+JOIN album_revenue r ON r.singer_id = n.singer_id
+WHERE n.singer_id = @singer_id;
@@ -0,0 +1,28 @@
+CREATE TABLE singers (
+  singer_id INT64 NOT NULL,
+  first_name STRING(MAX),
+  last_name STRING(MAX) NOT NULL,
+  birth_date DATE
+) PRIMARY KEY (singer_id);
+
+CREATE TABLE albums (
+  singer_id INT64 NOT NULL,
+  album_id INT64 NOT NULL,
+  title STRING(MAX) NOT NULL,
+  revenue NUMERIC
+) PRIMARY KEY (singer_id, album_id);
+
+CREATE VIEW singer_names SQL SECURITY INVOKER AS
+SELECT singers.singer_id, singers.last_name FROM singers;
+
+CREATE OR REPLACE VIEW singer_names SQL SECURITY INVOKER AS
+SELECT singers.singer_id, singers.first_name, singers.last_name FROM singers;
+
+CREATE VIEW album_revenue SQL SECURITY DEFINER AS
+SELECT albums.singer_id, COUNT(*) AS album_count
+FROM albums
+GROUP BY albums.singer_id;
+
+CREATE VIEW obsolete SQL SECURITY INVOKER AS SELECT singers.singer_id FROM singers;
+
+DROP VIEW obsolete;
@@ -0,0 +1,5 @@
+-- name: ListRevenue :many
+SELECT singer_id, album_count FROM album_revenue;
+
+-- name: ListTitles :many
+SELECT singer_id, title FROM album_titles;
@@ -0,0 +1,20 @@
+CREATE TABLE albums (
+  singer_id INT64 NOT NULL,
+  album_id INT64 NOT NULL,
+  title STRING(MAX) NOT NULL,
+  revenue NUMERIC
+) PRIMARY KEY (singer_id, album_id);
+
+CREATE VIEW album_revenue SQL SECURITY DEFINER AS
+SELECT albums.singer_id, COUNT(*) AS album_count
+FROM albums
+GROUP BY albums.singer_id;
+
+CREATE VIEW album_titles SQL SECURITY INVOKER AS
+SELECT albums.singer_id, albums.title, albums.revenue FROM albums;
+
+CREATE ROLE reporting;
+
+GRANT SELECT ON VIEW album_revenue, album_titles TO ROLE reporting;
+
+GRANT SELECT(singer_id, title) ON TABLE albums TO ROLE reporting;
@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.29.0
+//   sqlc v1.30.0
 // source: cte_test.sql
 
 package spanner_features
@@ -16,9 +16,9 @@ FROM users
 WHERE deleted_at IS NULL;
 `
 
-func (q *Queries) TestArrayAgg(ctx context.Context) (interface{}, error) {
+func (q *Queries) TestArrayAgg(ctx context.Context) ([]string, error) {
 	row := q.db.QueryRowContext(ctx, testArrayAgg)
-	var all_names interface{}
+	var all_names []string
 	err := row.Scan(&all_names)
 	return all_names, err
 }
@@ -81,8 +81,8 @@ type TestExtractDateRow struct {
 	Day   int64
 }
 
-func (q *Queries) TestExtractDate(ctx context.Context, id string) (TestExtractDateRow, error) {
-	row := q.db.QueryRowContext(ctx, testExtractDate, id)
+func (q *Queries) TestExtractDate(ctx context.Context, userID string) (TestExtractDateRow, error) {
+	row := q.db.QueryRowContext(ctx, testExtractDate, sql.Named("user_id", userID))
 	var i TestExtractDateRow
 	err := row.Scan(&i.Year, &i.Month, &i.Day)
 	return i, err
@@ -94,8 +94,8 @@ FROM users
 WHERE id = @user_id;
 `
 
-func (q *Queries) TestSafeDivide(ctx context.Context, id string) (interface{}, error) {
-	row := q.db.QueryRowContext(ctx, testSafeDivide, id)
+func (q *Queries) TestSafeDivide(ctx context.Context, userID string) (interface{}, error) {
+	row := q.db.QueryRowContext(ctx, testSafeDivide, sql.Named("user_id", userID))
 	var safe_score interface{}
 	err := row.Scan(&safe_score)
 	return safe_score, err
@@ -146,9 +146,9 @@ FROM users
 WHERE deleted_at IS NULL;
 `
 
-func (q *Queries) TestStringAgg(ctx context.Context) (string, error) {
+func (q *Queries) TestStringAgg(ctx context.Context) (sql.NullString, error) {
 	row := q.db.QueryRowContext(ctx, testStringAgg)
-	var names_list string
+	var names_list sql.NullString
 	err := row.Scan(&names_list)
 	return names_list, err
 }
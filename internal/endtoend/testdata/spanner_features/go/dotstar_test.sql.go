@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.29.0
+//   sqlc v1.30.0
 // source: dotstar_test.sql
 
 package spanner_features
@@ -11,15 +11,20 @@ import (
 )
 
 const testDotStarWithColumns = `-- name: TestDotStarWithColumns :many
-SELECT u.*, p.title
+SELECT u.id, u.name, u.email, u.score, u.status, u.deleted_at, p.title
 FROM users u
 JOIN posts p ON u.id = p.user_id
 WHERE u.deleted_at IS NULL;
 `
 
 type TestDotStarWithColumnsRow struct {
-	Column1 interface{}
-	Title   sql.NullString
+	ID        string
+	Name      sql.NullString
+	Email     sql.NullString
+	Score     sql.NullInt64
+	Status    sql.NullString
+	DeletedAt sql.NullTime
+	Title     sql.NullString
 }
 
 // Test table.* with additional columns
@@ -32,7 +37,15 @@ func (q *Queries) TestDotStarWithColumns(ctx context.Context) ([]TestDotStarWith
 	var items []TestDotStarWithColumnsRow
 	for rows.Next() {
 		var i TestDotStarWithColumnsRow
-		if err := rows.Scan(&i.Column1, &i.Title); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.Score,
+			&i.Status,
+			&i.DeletedAt,
+			&i.Title,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -48,26 +61,33 @@ func (q *Queries) TestDotStarWithColumns(ctx context.Context) ([]TestDotStarWith
 
 const testSimpleDotStar = `-- name: TestSimpleDotStar :many
 
-SELECT u.*
+SELECT u.id, u.name, u.email, u.score, u.status, u.deleted_at
 FROM users u
 WHERE u.deleted_at IS NULL;
 `
 
 // Test DotStar syntax
 // Test basic table.* syntax
-func (q *Queries) TestSimpleDotStar(ctx context.Context) ([]interface{}, error) {
+func (q *Queries) TestSimpleDotStar(ctx context.Context) ([]User, error) {
 	rows, err := q.db.QueryContext(ctx, testSimpleDotStar)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []interface{}
+	var items []User
 	for rows.Next() {
-		var column_1 interface{}
-		if err := rows.Scan(&column_1); err != nil {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.Score,
+			&i.Status,
+			&i.DeletedAt,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, column_1)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
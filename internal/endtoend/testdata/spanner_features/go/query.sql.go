@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.29.0
+//   sqlc v1.30.0
 // source: query.sql
 
 package spanner_features
@@ -87,8 +87,8 @@ FROM users WHERE id = @user_id;
 `
 
 // Test complex COALESCE with multiple arguments
-func (q *Queries) GetFirstNonNullValue(ctx context.Context, id string) (string, error) {
-	row := q.db.QueryRowContext(ctx, getFirstNonNullValue, id)
+func (q *Queries) GetFirstNonNullValue(ctx context.Context, userID string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getFirstNonNullValue, sql.Named("user_id", userID))
 	var first_value string
 	err := row.Scan(&first_value)
 	return first_value, err
@@ -100,8 +100,8 @@ FROM users WHERE id = @user_id;
 `
 
 // Test COALESCE function
-func (q *Queries) GetUserDisplayName(ctx context.Context, id string) (string, error) {
-	row := q.db.QueryRowContext(ctx, getUserDisplayName, id)
+func (q *Queries) GetUserDisplayName(ctx context.Context, userID string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserDisplayName, sql.Named("user_id", userID))
 	var display_name string
 	err := row.Scan(&display_name)
 	return display_name, err
@@ -125,8 +125,8 @@ type GetUserGradeRow struct {
 }
 
 // Test CASE WHEN expressions
-func (q *Queries) GetUserGrade(ctx context.Context, id string) (GetUserGradeRow, error) {
-	row := q.db.QueryRowContext(ctx, getUserGrade, id)
+func (q *Queries) GetUserGrade(ctx context.Context, userID string) (GetUserGradeRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserGrade, sql.Named("user_id", userID))
 	var i GetUserGradeRow
 	err := row.Scan(&i.Name, &i.Grade)
 	return i, err
@@ -138,8 +138,8 @@ FROM users WHERE id = @user_id;
 `
 
 // Test CAST operations
-func (q *Queries) GetUserIdAsInt(ctx context.Context, id string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getUserIdAsInt, id)
+func (q *Queries) GetUserIdAsInt(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getUserIdAsInt, sql.Named("user_id", userID))
 	var numeric_id int64
 	err := row.Scan(&numeric_id)
 	return numeric_id, err
@@ -151,8 +151,8 @@ FROM users WHERE id = @user_id;
 `
 
 // Test IFNULL function
-func (q *Queries) GetUserNameOrDefault(ctx context.Context, id string) (string, error) {
-	row := q.db.QueryRowContext(ctx, getUserNameOrDefault, id)
+func (q *Queries) GetUserNameOrDefault(ctx context.Context, userID string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserNameOrDefault, sql.Named("user_id", userID))
 	var user_name string
 	err := row.Scan(&user_name)
 	return user_name, err
@@ -164,8 +164,8 @@ FROM users WHERE id = @user_id;
 `
 
 // Test COALESCE with numbers
-func (q *Queries) GetUserScoreOrDefault(ctx context.Context, id string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getUserScoreOrDefault, id)
+func (q *Queries) GetUserScoreOrDefault(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getUserScoreOrDefault, sql.Named("user_id", userID))
 	var user_score int64
 	err := row.Scan(&user_score)
 	return user_score, err
@@ -177,8 +177,8 @@ FROM users WHERE id = @user_id;
 `
 
 // Test IFNULL with numbers
-func (q *Queries) GetUserScoreOrZero(ctx context.Context, id string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getUserScoreOrZero, id)
+func (q *Queries) GetUserScoreOrZero(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getUserScoreOrZero, sql.Named("user_id", userID))
 	var score_value int64
 	err := row.Scan(&score_value)
 	return score_value, err
@@ -190,9 +190,9 @@ FROM users WHERE id = @user_id;
 `
 
 // Test NULLIF function
-func (q *Queries) GetUserStatusNullIfDeleted(ctx context.Context, id string) (interface{}, error) {
-	row := q.db.QueryRowContext(ctx, getUserStatusNullIfDeleted, id)
-	var active_status interface{}
+func (q *Queries) GetUserStatusNullIfDeleted(ctx context.Context, userID string) (sql.NullString, error) {
+	row := q.db.QueryRowContext(ctx, getUserStatusNullIfDeleted, sql.Named("user_id", userID))
+	var active_status sql.NullString
 	err := row.Scan(&active_status)
 	return active_status, err
 }
@@ -326,8 +326,8 @@ SELECT
 `
 
 type TestArrayIndexAccessRow struct {
-	SecondFruit string
-	FirstNumber int64
+	SecondFruit interface{}
+	FirstNumber interface{}
 }
 
 // Test array index access
@@ -390,9 +390,9 @@ const testBooleanLiteral = `-- name: TestBooleanLiteral :one
 SELECT CASE WHEN true THEN true ELSE false END as bool_value;
 `
 
-func (q *Queries) TestBooleanLiteral(ctx context.Context) (string, error) {
+func (q *Queries) TestBooleanLiteral(ctx context.Context) (bool, error) {
 	row := q.db.QueryRowContext(ctx, testBooleanLiteral)
-	var bool_value string
+	var bool_value bool
 	err := row.Scan(&bool_value)
 	return bool_value, err
 }
@@ -414,9 +414,9 @@ FROM users WHERE id = @user_id;
 `
 
 // Test simple CASE with number in ELSE
-func (q *Queries) TestCaseWithNumberElse(ctx context.Context, id string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, testCaseWithNumberElse, id)
-	var result int64
+func (q *Queries) TestCaseWithNumberElse(ctx context.Context, userID string) (sql.NullInt64, error) {
+	row := q.db.QueryRowContext(ctx, testCaseWithNumberElse, sql.Named("user_id", userID))
+	var result sql.NullInt64
 	err := row.Scan(&result)
 	return result, err
 }
@@ -426,8 +426,8 @@ SELECT deleted_at as date_col FROM users WHERE id = @user_id;
 `
 
 // Debug: Test just returning a date column
-func (q *Queries) TestDateColumn(ctx context.Context, id string) (sql.NullTime, error) {
-	row := q.db.QueryRowContext(ctx, testDateColumn, id)
+func (q *Queries) TestDateColumn(ctx context.Context, userID string) (sql.NullTime, error) {
+	row := q.db.QueryRowContext(ctx, testDateColumn, sql.Named("user_id", userID))
 	var date_col sql.NullTime
 	err := row.Scan(&date_col)
 	return date_col, err
@@ -459,8 +459,8 @@ type TestExistsSubQueryRow struct {
 	HasPosts bool
 }
 
-func (q *Queries) TestExistsSubQuery(ctx context.Context, id string) (TestExistsSubQueryRow, error) {
-	row := q.db.QueryRowContext(ctx, testExistsSubQuery, id)
+func (q *Queries) TestExistsSubQuery(ctx context.Context, userID string) (TestExistsSubQueryRow, error) {
+	row := q.db.QueryRowContext(ctx, testExistsSubQuery, sql.Named("user_id", userID))
 	var i TestExistsSubQueryRow
 	err := row.Scan(&i.ID, &i.Name, &i.HasPosts)
 	return i, err
@@ -542,8 +542,8 @@ FROM users u
 WHERE u.id = @user_id;
 `
 
-func (q *Queries) TestMixedStruct(ctx context.Context, id string) (interface{}, error) {
-	row := q.db.QueryRowContext(ctx, testMixedStruct, id)
+func (q *Queries) TestMixedStruct(ctx context.Context, userID string) (interface{}, error) {
+	row := q.db.QueryRowContext(ctx, testMixedStruct, sql.Named("user_id", userID))
 	var mixed_score interface{}
 	err := row.Scan(&mixed_score)
 	return mixed_score, err
@@ -553,9 +553,9 @@ const testNullLiteral = `-- name: TestNullLiteral :one
 SELECT CASE WHEN false THEN 'value' ELSE NULL END as null_value;
 `
 
-func (q *Queries) TestNullLiteral(ctx context.Context) (interface{}, error) {
+func (q *Queries) TestNullLiteral(ctx context.Context) (sql.NullString, error) {
 	row := q.db.QueryRowContext(ctx, testNullLiteral)
-	var null_value interface{}
+	var null_value sql.NullString
 	err := row.Scan(&null_value)
 	return null_value, err
 }
@@ -581,12 +581,12 @@ WHERE u.id = @user_id;
 
 type TestScalarSubQueryRow struct {
 	Name     sql.NullString
-	MaxScore interface{}
+	MaxScore sql.NullInt64
 }
 
 // Test subquery support
-func (q *Queries) TestScalarSubQuery(ctx context.Context, id string) (TestScalarSubQueryRow, error) {
-	row := q.db.QueryRowContext(ctx, testScalarSubQuery, id)
+func (q *Queries) TestScalarSubQuery(ctx context.Context, userID string) (TestScalarSubQueryRow, error) {
+	row := q.db.QueryRowContext(ctx, testScalarSubQuery, sql.Named("user_id", userID))
 	var i TestScalarSubQueryRow
 	err := row.Scan(&i.Name, &i.MaxScore)
 	return i, err
@@ -643,9 +643,9 @@ SELECT
 `
 
 // Test struct field access
-func (q *Queries) TestStructFieldAccess(ctx context.Context) (string, error) {
+func (q *Queries) TestStructFieldAccess(ctx context.Context) (interface{}, error) {
 	row := q.db.QueryRowContext(ctx, testStructFieldAccess)
-	var person_name string
+	var person_name interface{}
 	err := row.Scan(&person_name)
 	return person_name, err
 }
@@ -655,9 +655,9 @@ SELECT
   STRUCT<id INT64, name STRING>(42, 'Alice').name as typed_name;
 `
 
-func (q *Queries) TestStructFieldAccess2(ctx context.Context) (string, error) {
+func (q *Queries) TestStructFieldAccess2(ctx context.Context) (interface{}, error) {
 	row := q.db.QueryRowContext(ctx, testStructFieldAccess2)
-	var typed_name string
+	var typed_name interface{}
 	err := row.Scan(&typed_name)
 	return typed_name, err
 }
@@ -715,9 +715,9 @@ SELECT
   STRUCT(1 as id, 'John' as name).id as person_id;
 `
 
-func (q *Queries) TestStructFieldAccessInt(ctx context.Context) (int64, error) {
+func (q *Queries) TestStructFieldAccessInt(ctx context.Context) (interface{}, error) {
 	row := q.db.QueryRowContext(ctx, testStructFieldAccessInt)
-	var person_id int64
+	var person_id interface{}
 	err := row.Scan(&person_id)
 	return person_id, err
 }
@@ -727,9 +727,9 @@ SELECT
   STRUCT<id INT64, name STRING, active BOOL>(42, 'Alice', true).id as typed_id;
 `
 
-func (q *Queries) TestStructFieldAccessTypedInt(ctx context.Context) (int64, error) {
+func (q *Queries) TestStructFieldAccessTypedInt(ctx context.Context) (interface{}, error) {
 	row := q.db.QueryRowContext(ctx, testStructFieldAccessTypedInt)
-	var typed_id int64
+	var typed_id interface{}
 	err := row.Scan(&typed_id)
 	return typed_id, err
 }
@@ -742,8 +742,8 @@ WHERE u.id = @user_id;
 `
 
 // Test STRUCT with table column references
-func (q *Queries) TestStructWithTableColumns(ctx context.Context, id string) (interface{}, error) {
-	row := q.db.QueryRowContext(ctx, testStructWithTableColumns, id)
+func (q *Queries) TestStructWithTableColumns(ctx context.Context, userID string) (interface{}, error) {
+	row := q.db.QueryRowContext(ctx, testStructWithTableColumns, sql.Named("user_id", userID))
 	var name_from_struct interface{}
 	err := row.Scan(&name_from_struct)
 	return name_from_struct, err
@@ -756,8 +756,8 @@ FROM users u
 WHERE u.id = @user_id;
 `
 
-func (q *Queries) TestStructWithTableColumnsInt(ctx context.Context, id string) (interface{}, error) {
-	row := q.db.QueryRowContext(ctx, testStructWithTableColumnsInt, id)
+func (q *Queries) TestStructWithTableColumnsInt(ctx context.Context, userID string) (interface{}, error) {
+	row := q.db.QueryRowContext(ctx, testStructWithTableColumnsInt, sql.Named("user_id", userID))
 	var score_from_struct interface{}
 	err := row.Scan(&score_from_struct)
 	return score_from_struct, err
@@ -803,8 +803,8 @@ FROM users u
 WHERE u.id = @user_id;
 `
 
-func (q *Queries) TestTypedStructWithTableColumns(ctx context.Context, id string) (interface{}, error) {
-	row := q.db.QueryRowContext(ctx, testTypedStructWithTableColumns, id)
+func (q *Queries) TestTypedStructWithTableColumns(ctx context.Context, userID string) (interface{}, error) {
+	row := q.db.QueryRowContext(ctx, testTypedStructWithTableColumns, sql.Named("user_id", userID))
 	var typed_name interface{}
 	err := row.Scan(&typed_name)
 	return typed_name, err
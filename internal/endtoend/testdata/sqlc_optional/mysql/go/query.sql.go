@@ -0,0 +1,88 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"strings"
+)
+
+const countUsers = `-- name: CountUsers :one
+SELECT count(*) FROM users
+WHERE /*OPTIONAL:pattern*/name LIKE ?/*END*/
+`
+
+func (q *Queries) CountUsers(ctx context.Context, pattern *string) (int64, error) {
+	query := countUsers
+	var queryParams []interface{}
+	if pattern != nil {
+		queryParams = append(queryParams, *pattern)
+	} else {
+		query = strings.Replace(query, "/*OPTIONAL:pattern*/name LIKE ?/*END*/", "TRUE", 1)
+	}
+	row := q.db.QueryRowContext(ctx, query, queryParams...)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, name FROM users
+WHERE status = ?
+  AND /*OPTIONAL:name*/name = ?/*END*/
+  AND /*OPTIONAL:min_age*/age >= ?/*END*/
+ORDER BY id
+LIMIT ?
+`
+
+type ListUsersParams struct {
+	Status string
+	Name   *string
+	MinAge *int32
+	Limit  int32
+}
+
+type ListUsersRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]ListUsersRow, error) {
+	query := listUsers
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Status)
+	if arg.Name != nil {
+		queryParams = append(queryParams, *arg.Name)
+	} else {
+		query = strings.Replace(query, "/*OPTIONAL:name*/name = ?/*END*/", "TRUE", 1)
+	}
+	if arg.MinAge != nil {
+		queryParams = append(queryParams, *arg.MinAge)
+	} else {
+		query = strings.Replace(query, "/*OPTIONAL:min_age*/age >= ?/*END*/", "TRUE", 1)
+	}
+	queryParams = append(queryParams, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersRow
+	for rows.Next() {
+		var i ListUsersRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,21 @@
+-- name: ListUsers :many
+SELECT id, name FROM users
+WHERE status = @status
+  AND name = sqlc.optional('name')
+  AND age >= sqlc.optional('min_age')
+ORDER BY id
+LIMIT @max_results;
+
+-- name: CountUsers :one
+SELECT count(*) FROM users
+WHERE lower(name) LIKE lower(sqlc.optional('pattern'));
+
+-- name: ListUsersCreatedBetween :many
+SELECT id FROM users
+WHERE created_at BETWEEN @since AND @until
+  AND (status = sqlc.optional('status'));
+
+-- name: DeleteUsers :exec
+DELETE FROM users
+WHERE status = $1
+  AND age < sqlc.optional('max_age');
@@ -0,0 +1,92 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+const countUsers = `-- name: CountUsers :one
+SELECT count(*) FROM users
+WHERE /*OPTIONAL:pattern*/name LIKE ?1/*END*/
+`
+
+func (q *Queries) CountUsers(ctx context.Context, pattern *string) (int64, error) {
+	query := countUsers
+	var queryParams []interface{}
+	if pattern != nil {
+		queryParams = append(queryParams, *pattern)
+		query = strings.Replace(query, "/*OPTIONAL:pattern*/name LIKE ?1/*END*/", "name LIKE ?"+strconv.Itoa(len(queryParams)), 1)
+	} else {
+		query = strings.Replace(query, "/*OPTIONAL:pattern*/name LIKE ?1/*END*/", "TRUE", 1)
+	}
+	row := q.db.QueryRowContext(ctx, query, queryParams...)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, name FROM users
+WHERE status = ?1
+  AND /*OPTIONAL:name*/name = ?3/*END*/
+  AND /*OPTIONAL:min_age*/age >= ?4/*END*/
+ORDER BY id
+LIMIT ?2
+`
+
+type ListUsersParams struct {
+	Status     string
+	MaxResults int64
+	Name       *string
+	MinAge     *int64
+}
+
+type ListUsersRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]ListUsersRow, error) {
+	query := listUsers
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Status)
+	queryParams = append(queryParams, arg.MaxResults)
+	if arg.Name != nil {
+		queryParams = append(queryParams, *arg.Name)
+		query = strings.Replace(query, "/*OPTIONAL:name*/name = ?3/*END*/", "name = ?"+strconv.Itoa(len(queryParams)), 1)
+	} else {
+		query = strings.Replace(query, "/*OPTIONAL:name*/name = ?3/*END*/", "TRUE", 1)
+	}
+	if arg.MinAge != nil {
+		queryParams = append(queryParams, *arg.MinAge)
+		query = strings.Replace(query, "/*OPTIONAL:min_age*/age >= ?4/*END*/", "age >= ?"+strconv.Itoa(len(queryParams)), 1)
+	} else {
+		query = strings.Replace(query, "/*OPTIONAL:min_age*/age >= ?4/*END*/", "TRUE", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersRow
+	for rows.Next() {
+		var i ListUsersRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,11 @@
+-- name: ListUsers :many
+SELECT id, name FROM users
+WHERE status = sqlc.arg(status)
+  AND name = sqlc.optional(name)
+  AND age >= sqlc.optional(min_age)
+ORDER BY id
+LIMIT sqlc.arg(max_results);
+
+-- name: CountUsers :one
+SELECT count(*) FROM users
+WHERE name LIKE sqlc.optional(pattern);
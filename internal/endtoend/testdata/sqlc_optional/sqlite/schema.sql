@@ -0,0 +1,6 @@
+CREATE TABLE users (
+  id INTEGER NOT NULL PRIMARY KEY,
+  name TEXT NOT NULL,
+  status TEXT NOT NULL,
+  age INTEGER
+);
@@ -0,0 +1,10 @@
+-- name: OrCondition :many
+SELECT id FROM users
+WHERE status = @status OR name = sqlc.optional('name');
+
+-- name: OtherParameter :many
+SELECT id FROM users
+WHERE age BETWEEN sqlc.optional('min_age') AND @max_age;
+
+-- name: SelectList :many
+SELECT sqlc.optional('name')::text FROM users;
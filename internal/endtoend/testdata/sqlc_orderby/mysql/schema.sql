@@ -0,0 +1,6 @@
+CREATE TABLE users (
+  id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name TEXT NOT NULL,
+  status TEXT NOT NULL,
+  age INT
+);
@@ -0,0 +1,14 @@
+-- name: ListUsers :many
+SELECT id, name, created_at FROM users
+WHERE status = @status
+ORDER BY sqlc.orderby('sort', 'name', 'created_at DESC', 'created_at'), id
+LIMIT @max_results;
+
+-- name: ListAllUsers :many
+SELECT id, name FROM users
+ORDER BY sqlc.orderby(sort, name, id);
+
+-- name: SearchUsers :many
+SELECT id, name FROM users
+WHERE name LIKE sqlc.optional('pattern')
+ORDER BY sqlc.orderby('sort', 'name', 'id DESC');
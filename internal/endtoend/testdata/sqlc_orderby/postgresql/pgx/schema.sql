@@ -0,0 +1,7 @@
+CREATE TABLE users (
+  id BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL,
+  status TEXT NOT NULL,
+  age INTEGER,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
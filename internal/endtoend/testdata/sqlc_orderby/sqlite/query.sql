@@ -0,0 +1,14 @@
+-- name: ListUsers :many
+SELECT id, name, age FROM users
+WHERE status = sqlc.arg(status)
+ORDER BY sqlc.orderby(sort, 'name', 'age DESC', 'age'), id
+LIMIT sqlc.arg(max_results);
+
+-- name: ListAllUsers :many
+SELECT id, name FROM users
+ORDER BY sqlc.orderby(sort, name, id);
+
+-- name: SearchUsers :many
+SELECT id, name FROM users
+WHERE name LIKE sqlc.optional(pattern)
+ORDER BY sqlc.orderby(sort, 'name', 'id DESC');
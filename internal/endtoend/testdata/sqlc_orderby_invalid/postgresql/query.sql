@@ -0,0 +1,16 @@
+-- name: UnknownColumn :many
+SELECT id, name FROM users
+ORDER BY sqlc.orderby('sort', 'name', 'status');
+
+-- name: InvalidKey :many
+SELECT id, name FROM users
+ORDER BY sqlc.orderby('sort', 'name; DROP TABLE users');
+
+-- name: NotAnItem :many
+SELECT id, name FROM users
+ORDER BY lower(sqlc.orderby('sort', 'name'));
+
+-- name: SameName :many
+SELECT id, name FROM users
+WHERE status = @sort
+ORDER BY sqlc.orderby('sort', 'name');
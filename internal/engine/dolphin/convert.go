@@ -220,6 +220,21 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 	}
 	for _, def := range n.Cols {
 		create.Cols = append(create.Cols, convertColumnDef(def))
+		for _, opt := range def.Options {
+			if opt.Tp == pcast.ColumnOptionPrimaryKey {
+				create.PrimaryKey = append(create.PrimaryKey, def.Name.String())
+			}
+		}
+	}
+	for _, con := range n.Constraints {
+		if con.Tp != pcast.ConstraintPrimaryKey {
+			continue
+		}
+		for _, key := range con.Keys {
+			if key.Column != nil {
+				create.PrimaryKey = append(create.PrimaryKey, key.Column.Name.String())
+			}
+		}
 	}
 	for _, opt := range n.Options {
 		switch opt.Tp {
@@ -420,6 +420,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 					for _, key := range item.Constraint.Keys {
 						// FIXME: Possible nil pointer dereference
 						primaryKey[key.Node.(*nodes.Node_String_).String_.Sval] = true
+						create.PrimaryKey = append(create.PrimaryKey, key.Node.(*nodes.Node_String_).String_.Sval)
 					}
 				}
 
@@ -440,6 +441,9 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				for _, con := range item.ColumnDef.Constraints {
 					if constraint, ok := con.Node.(*nodes.Node_Constraint); ok {
 						primary = constraint.Constraint.Contype == nodes.ConstrType_CONSTR_PRIMARY
+						if primary {
+							create.PrimaryKey = append(create.PrimaryKey, item.ColumnDef.Colname)
+						}
 					}
 				}
 
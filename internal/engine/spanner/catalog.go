@@ -1,6 +1,9 @@
 package spanner
 
 import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
 )
 
@@ -16,3 +19,44 @@ func NewCatalog() *catalog.Catalog {
 		Extensions: map[string]struct{}{},
 	}
 }
+
+// The built-in functions in stdlib.go are generated from functions.json by
+// sqlc-spanner-gen.
+func defaultSchema(name string) *catalog.Schema {
+	s := &catalog.Schema{Name: name}
+	s.Funcs = append(s.Funcs, funcsStdlib...)
+
+	// Automatically generate SAFE. versions for most functions
+	// SAFE. prefix makes functions return NULL instead of raising errors
+	for _, fn := range funcsStdlib {
+		// Skip functions that already have SAFE. prefix (avoid SAFE.SAFE.function)
+		// Note: SAFE_* functions (like SAFE_ADD, SAFE_DIVIDE) are different from SAFE. prefix
+		// and can have their own SAFE. versions (e.g., SAFE.SAFE_DIVIDE is valid)
+		if strings.HasPrefix(fn.Name, "SAFE.") {
+			continue
+		}
+		// Skip aggregate functions (they don't have SAFE. versions)
+		if isAggregateFunction(fn.Name) {
+			continue
+		}
+
+		// Create SAFE. version (works for both regular and namespaced functions)
+		safeFn := &catalog.Function{
+			Name:               "SAFE." + fn.Name,
+			Args:               fn.Args,
+			ReturnType:         fn.ReturnType,
+			ReturnTypeNullable: true, // SAFE functions always return nullable types
+		}
+		s.Funcs = append(s.Funcs, safeFn)
+	}
+
+	return s
+}
+
+// IsAggregate reports whether call is a call of an aggregate function
+func IsAggregate(call *ast.FuncCall) bool {
+	if call.Func == nil {
+		return false
+	}
+	return isAggregateFunction(strings.ToUpper(call.Func.Name))
+}
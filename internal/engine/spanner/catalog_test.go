@@ -0,0 +1,105 @@
+package spanner
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+type functionList struct {
+	Functions []struct {
+		Name       string `json:"name"`
+		Aggregate  bool   `json:"aggregate"`
+		Signatures []struct {
+			Args []struct {
+				Type     string `json:"type"`
+				Optional bool   `json:"optional"`
+				Variadic bool   `json:"variadic"`
+			} `json:"args"`
+			Returns string `json:"returns"`
+		} `json:"signatures"`
+	} `json:"functions"`
+}
+
+func call(name string, args int) *ast.FuncCall {
+	fc := &ast.FuncCall{
+		Func: &ast.FuncName{Name: name},
+		Args: &ast.List{},
+	}
+	for i := 0; i < args; i++ {
+		fc.Args.Items = append(fc.Args.Items, &ast.TODO{})
+	}
+	return fc
+}
+
+func hasSignature(funcs []catalog.Function, args []string, returns string) bool {
+	for _, fn := range funcs {
+		if fn.ReturnType.Name != returns || len(fn.Args) != len(args) {
+			continue
+		}
+		match := true
+		for i := range args {
+			if fn.Args[i].Type.Name != args[i] {
+				match = false
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// Every function in functions.json must be in the catalog, so stdlib.go needs
+// to be regenerated with sqlc-spanner-gen after editing the list.
+func TestStdlibFunctionsResolve(t *testing.T) {
+	blob, err := os.ReadFile("functions.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var list functionList
+	if err := json.Unmarshal(blob, &list); err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewCatalog()
+	for _, fn := range list.Functions {
+		t.Run(fn.Name, func(t *testing.T) {
+			if got := isAggregateFunction(fn.Name); got != fn.Aggregate {
+				t.Errorf("isAggregateFunction(%q) = %v, want %v", fn.Name, got, fn.Aggregate)
+			}
+			funcs, err := c.ListFuncsByName(&ast.FuncName{Name: fn.Name})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, sig := range fn.Signatures {
+				var types []string
+				required := 0
+				for _, arg := range sig.Args {
+					types = append(types, arg.Type)
+					if !arg.Optional && !arg.Variadic {
+						required++
+					}
+				}
+				if !hasSignature(funcs, types, sig.Returns) {
+					t.Errorf("%s%v returning %s is missing from the catalog", fn.Name, types, sig.Returns)
+				}
+
+				names := []string{fn.Name}
+				if !fn.Aggregate {
+					names = append(names, "SAFE."+fn.Name)
+				}
+				for _, name := range names {
+					for _, n := range []int{required, len(sig.Args)} {
+						if _, err := c.ResolveFuncCall(call(name, n)); err != nil {
+							t.Errorf("%s with %d arguments: %s", name, n, err)
+						}
+					}
+				}
+			}
+		})
+	}
+}
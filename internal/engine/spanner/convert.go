@@ -28,22 +28,51 @@ import (
 
 	"github.com/sqlc-dev/sqlc/internal/debug"
 	sqlcast "github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 )
 
 type cc struct {
-	paramCount     int
-	paramMap       map[string]int // Map parameter names to their position
-	paramsByNum    map[int]string // Map position to parameter name
-	positionOffset int            // Offset to adjust AST positions to file positions
+	positionOffset int   // Offset to adjust AST positions to file positions
+	err            error // First unsupported construct found by the conversion
 }
 
-func todo(funcname string, n ast.Node) *sqlcast.TODO {
+// unsupported records that a GoogleSQL construct can't be converted, so that
+// Parse fails instead of typing the query with a placeholder. The conversion
+// carries on with a TODO node.
+func (c *cc) unsupported(construct string, n ast.Node) *sqlcast.TODO {
 	if debug.Active {
-		log.Printf("spanner.%s: Unknown node type %T\n", funcname, n)
+		log.Printf("spanner: %s is not supported (%T)\n", construct, n)
+	}
+	if c.err == nil {
+		c.err = &sqlerr.Error{
+			Message:  fmt.Sprintf("%s is not supported", construct),
+			Location: int(n.Pos()) + c.positionOffset,
+		}
 	}
 	return &sqlcast.TODO{}
 }
 
+// constructName returns the GoogleSQL name of a node for error messages
+func constructName(n ast.Node) string {
+	switch n.(type) {
+	case *ast.Call:
+		return "CALL"
+	case *ast.FromQuery:
+		return "pipe syntax"
+	case *ast.ReplaceFieldsExpr:
+		return "REPLACE_FIELDS"
+	case *ast.WithExpr:
+		return "WITH expression"
+	case *ast.NewConstructor, *ast.BracedNewConstructor:
+		return "NEW constructor"
+	case *ast.BracedConstructor:
+		return "braced constructor"
+	case *ast.PathTableExpr:
+		return "path expression in FROM"
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", n), "*ast.")
+}
+
 func identifier(id string) string {
 	// Spanner identifiers are case-insensitive
 	return strings.ToLower(id)
@@ -60,6 +89,10 @@ func (c *cc) convert(n ast.Node) sqlcast.Node {
 
 	switch node := n.(type) {
 	// DDL Statements
+	case *ast.CreateSchema:
+		return c.convertCreateSchema(node)
+	case *ast.DropSchema:
+		return c.convertDropSchema(node)
 	case *ast.CreateTable:
 		return c.convertCreateTable(node)
 	case *ast.DropTable:
@@ -70,10 +103,40 @@ func (c *cc) convert(n ast.Node) sqlcast.Node {
 		return c.convertDropIndex(node)
 	case *ast.AlterTable:
 		return c.convertAlterTable(node)
+	case *ast.RenameTable:
+		return c.convertRenameTable(node)
 	case *ast.CreateView:
 		return c.convertCreateView(node)
 	case *ast.DropView:
 		return c.convertDropView(node)
+	case *ast.CreateModel:
+		return c.convertCreateModel(node)
+	case *ast.DropModel:
+		return c.convertDropModel(node)
+	case *ast.CreateChangeStream:
+		return c.convertCreateChangeStream(node)
+	case *ast.DropChangeStream:
+		return c.convertDropChangeStream(node)
+	case *ast.CreateRole:
+		return c.convertCreateRole(node)
+	case *ast.DropRole:
+		return c.convertDropRole(node)
+	case *ast.Grant:
+		return c.convertGrant(node.Privilege, node.Roles, true)
+	case *ast.Revoke:
+		return c.convertGrant(node.Privilege, node.Roles, false)
+
+	// DDL that doesn't affect the catalog
+	case *ast.CreateDatabase, *ast.AlterDatabase, *ast.CreatePlacement,
+		*ast.AlterChangeStream, *ast.AlterIndex, *ast.AlterModel,
+		*ast.AlterStatistics, *ast.Analyze,
+		*ast.CreateLocalityGroup, *ast.AlterLocalityGroup, *ast.DropLocalityGroup,
+		*ast.CreateSequence, *ast.AlterSequence, *ast.DropSequence,
+		*ast.CreateSearchIndex, *ast.AlterSearchIndex, *ast.DropSearchIndex,
+		*ast.CreateVectorIndex, *ast.AlterVectorIndex, *ast.DropVectorIndex,
+		*ast.CreateProtoBundle, *ast.AlterProtoBundle, *ast.DropProtoBundle,
+		*ast.CreatePropertyGraph, *ast.DropPropertyGraph:
+		return &sqlcast.TODO{}
 
 	// DML Statements
 	case *ast.Insert:
@@ -90,6 +153,11 @@ func (c *cc) convert(n ast.Node) sqlcast.Node {
 		return c.convertQuery(node)
 	case *ast.Select:
 		return c.convertSelect(node)
+	case *ast.CompoundQuery:
+		return c.convertCompoundQuery(node)
+	case *ast.SubQuery:
+		// Parenthesized query expression
+		return c.convert(node.Query)
 
 	// Expressions
 	case *ast.Ident:
@@ -120,6 +188,8 @@ func (c *cc) convert(n ast.Node) sqlcast.Node {
 		return c.convertInExpr(node)
 	case *ast.IsNullExpr:
 		return c.convertIsNullExpr(node)
+	case *ast.IsBoolExpr:
+		return c.convertIsBoolExpr(node)
 	case *ast.BetweenExpr:
 		return c.convertBetweenExpr(node)
 	case *ast.ExtractExpr:
@@ -197,12 +267,25 @@ func (c *cc) convert(n ast.Node) sqlcast.Node {
 		}
 
 	default:
-		return todo("convert", n)
+		return c.unsupported(constructName(n), n)
 	}
 }
 
 // DDL Conversions
-func (c *cc) convertCreateTable(n *ast.CreateTable) *sqlcast.CreateTableStmt {
+func (c *cc) convertCreateSchema(n *ast.CreateSchema) *sqlcast.CreateSchemaStmt {
+	name := identifier(n.Name.Name)
+	return &sqlcast.CreateSchemaStmt{
+		Name: &name,
+	}
+}
+
+func (c *cc) convertDropSchema(n *ast.DropSchema) *sqlcast.DropSchemaStmt {
+	return &sqlcast.DropSchemaStmt{
+		Schemas: []*sqlcast.String{NewIdentifier(n.Name.Name)},
+	}
+}
+
+func (c *cc) convertCreateTable(n *ast.CreateTable) sqlcast.Node {
 	stmt := &sqlcast.CreateTableStmt{
 		IfNotExists: n.IfNotExists,
 		Name:        parseTableName(n.Name),
@@ -211,30 +294,346 @@ func (c *cc) convertCreateTable(n *ast.CreateTable) *sqlcast.CreateTableStmt {
 
 	// Convert columns
 	for _, col := range n.Columns {
-		typeName := c.convertSchemaType(col.Type)
-		colDef := &sqlcast.ColumnDef{
-			Colname: identifier(col.Name.Name),
-			TypeName: &sqlcast.TypeName{
-				Name: typeName,
-				Names: &sqlcast.List{
-					Items: []sqlcast.Node{
-						&sqlcast.String{Str: typeName},
-					},
+		stmt.Cols = append(stmt.Cols, c.convertColumnDef(col))
+		if col.PrimaryKey {
+			stmt.PrimaryKey = append(stmt.PrimaryKey, identifier(col.Name.Name))
+		}
+	}
+	for _, key := range n.PrimaryKeys {
+		stmt.PrimaryKey = append(stmt.PrimaryKey, identifier(key.Name.Name))
+	}
+
+	// Foreign keys and the parent of an interleaved table are recorded like
+	// their ALTER TABLE forms. ROW DELETION POLICY and CHECK constraints
+	// don't change the catalog.
+	var cmds []sqlcast.Node
+	for _, tc := range n.TableConstraints {
+		if cmd := c.foreignKeyCmd(tc); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if n.Cluster != nil {
+		cmds = append(cmds, interleaveCmd(n.Cluster.TableName))
+	}
+	if len(n.Synonyms) == 0 && len(cmds) == 0 {
+		return stmt
+	}
+
+	// A table created with synonyms is added to the catalog before them
+	list := &sqlcast.List{Items: []sqlcast.Node{stmt}}
+	for _, syn := range n.Synonyms {
+		list.Items = append(list.Items, synonymStmt(stmt.Name, sqlcast.AT_AddSynonym, syn.Name))
+	}
+	if len(cmds) > 0 {
+		list.Items = append(list.Items, &sqlcast.AlterTableStmt{
+			Table: stmt.Name,
+			Cmds:  &sqlcast.List{Items: cmds},
+		})
+	}
+	return list
+}
+
+// foreignKeyCmd adds a FOREIGN KEY constraint, which may be unnamed, to a
+// table. It returns nil for CHECK constraints.
+func (c *cc) foreignKeyCmd(tc *ast.TableConstraint) *sqlcast.AlterTableCmd {
+	fk, ok := tc.Constraint.(*ast.ForeignKey)
+	if !ok {
+		return nil
+	}
+	columns := func(idents []*ast.Ident) *sqlcast.List {
+		list := &sqlcast.List{}
+		for _, ident := range idents {
+			list.Items = append(list.Items, NewIdentifier(ident.Name))
+		}
+		return list
+	}
+	con := &sqlcast.Constraint{
+		Pktable:  convertTableNameToRangeVar(fk.ReferenceTable),
+		FkAttrs:  columns(fk.Columns),
+		PkAttrs:  columns(fk.ReferenceColumns),
+		Location: int(fk.Pos()) + c.positionOffset,
+	}
+	if tc.Name != nil {
+		name := identifier(tc.Name.Name)
+		con.Conname = &name
+	}
+	return &sqlcast.AlterTableCmd{
+		Subtype:    sqlcast.AT_AddConstraint,
+		Constraint: con,
+	}
+}
+
+// interleaveCmd interleaves a table in its parent, with INTERLEAVE IN PARENT
+// or INTERLEAVE IN.
+func interleaveCmd(parent *ast.Path) *sqlcast.AlterTableCmd {
+	return &sqlcast.AlterTableCmd{
+		Subtype: sqlcast.AT_SetInterleaveIn,
+		Parent:  parseTableName(parent),
+	}
+}
+
+func (c *cc) convertColumnDef(col *ast.ColumnDef) *sqlcast.ColumnDef {
+	typeName, dims := c.convertColumnType(col.Type)
+	return &sqlcast.ColumnDef{
+		Colname:              identifier(col.Name.Name),
+		TypeName:             typeName,
+		IsNotNull:            col.NotNull,
+		IsArray:              dims > 0,
+		ArrayDims:            dims,
+		AllowCommitTimestamp: allowCommitTimestamp(col.Options),
+	}
+}
+
+// convertColumnType returns the type of a column and its number of array
+// dimensions. ARRAY<T> columns have the type of their elements.
+func (c *cc) convertColumnType(t ast.SchemaType) (*sqlcast.TypeName, int) {
+	dims := 0
+	if arr, ok := t.(*ast.ArraySchemaType); ok {
+		t = arr.Item
+		dims = 1
+	}
+	typeName := c.convertSchemaType(t)
+	return &sqlcast.TypeName{
+		Name: typeName,
+		Names: &sqlcast.List{
+			Items: []sqlcast.Node{
+				&sqlcast.String{Str: typeName},
+			},
+		},
+	}, dims
+}
+
+func synonymStmt(table *sqlcast.TableName, subtype sqlcast.AlterTableType, name *ast.Ident) *sqlcast.AlterTableStmt {
+	synonym := identifier(name.Name)
+	return &sqlcast.AlterTableStmt{
+		Table: table,
+		Cmds: &sqlcast.List{
+			Items: []sqlcast.Node{
+				&sqlcast.AlterTableCmd{
+					Subtype: subtype,
+					Name:    &synonym,
 				},
 			},
-			IsNotNull: col.NotNull,
+		},
+	}
+}
+
+func findOption(opts *ast.Options, name string) *ast.OptionsDef {
+	if opts == nil {
+		return nil
+	}
+	for _, opt := range opts.Records {
+		if strings.EqualFold(opt.Name.Name, name) {
+			return opt
+		}
+	}
+	return nil
+}
+
+// allowCommitTimestamp reports whether a column is declared with
+// OPTIONS (allow_commit_timestamp = true).
+func allowCommitTimestamp(opts *ast.Options) bool {
+	v, _ := boolOption(opts, "allow_commit_timestamp")
+	return v
+}
+
+// boolOption returns the value of a boolean option, and whether it was set.
+func boolOption(opts *ast.Options, name string) (bool, bool) {
+	opt := findOption(opts, name)
+	if opt == nil {
+		return false, false
+	}
+	if v, ok := opt.Value.(*ast.BoolLiteral); ok {
+		return v.Value, true
+	}
+	return false, false
+}
+
+func (c *cc) convertCreateModel(n *ast.CreateModel) *sqlcast.CreateModelStmt {
+	stmt := &sqlcast.CreateModelStmt{
+		Name:        &sqlcast.TableName{Name: identifier(n.Name.Name)},
+		Replace:     n.OrReplace,
+		IfNotExists: n.IfNotExists,
+	}
+	if n.InputOutput != nil {
+		stmt.Inputs = c.convertModelColumns(n.InputOutput.InputColumns)
+		stmt.Outputs = c.convertModelColumns(n.InputOutput.OutputColumns)
+	}
+	return stmt
+}
+
+// Model columns are required unless declared with OPTIONS (required = false).
+func (c *cc) convertModelColumns(cols []*ast.CreateModelColumn) []*sqlcast.ColumnDef {
+	defs := make([]*sqlcast.ColumnDef, 0, len(cols))
+	for _, col := range cols {
+		typeName, dims := c.convertColumnType(col.DataType)
+		required, ok := boolOption(col.Options, "required")
+		defs = append(defs, &sqlcast.ColumnDef{
+			Colname:   identifier(col.Name.Name),
+			TypeName:  typeName,
+			IsNotNull: required || !ok,
+			IsArray:   dims > 0,
+			ArrayDims: dims,
+		})
+	}
+	return defs
+}
+
+func (c *cc) convertDropModel(n *ast.DropModel) *sqlcast.DropModelStmt {
+	return &sqlcast.DropModelStmt{
+		IfExists: n.IfExists,
+		Models: []*sqlcast.TableName{
+			{Name: identifier(n.Name.Name)},
+		},
+	}
+}
+
+// A change stream is read with its READ_<name> table-valued function, so
+// creating one defines that function. Each row holds a single ChangeRecord
+// column, named in lower case like every other Spanner identifier, of the
+// change_record type that code generators map to the documented record shape.
+//
+// https://cloud.google.com/spanner/docs/change-streams/details#query
+func (c *cc) convertCreateChangeStream(n *ast.CreateChangeStream) *sqlcast.CreateFunctionStmt {
+	param := func(name, typ string, mode sqlcast.FuncParamMode) *sqlcast.FuncParam {
+		return &sqlcast.FuncParam{
+			Name: &name,
+			Type: &sqlcast.TypeName{Name: typ},
+			Mode: mode,
 		}
-		stmt.Cols = append(stmt.Cols, colDef)
 	}
+	readOptions := param("read_options", "string[]", sqlcast.FuncParamIn)
+	readOptions.DefExpr = &sqlcast.TODO{}
+	return &sqlcast.CreateFunctionStmt{
+		Func: changeStreamFuncName(n.Name),
+		Params: &sqlcast.List{
+			Items: []sqlcast.Node{
+				param("start_timestamp", "timestamp", sqlcast.FuncParamIn),
+				param("end_timestamp", "timestamp", sqlcast.FuncParamIn),
+				param("partition_token", "string", sqlcast.FuncParamIn),
+				param("heartbeat_milliseconds", "int64", sqlcast.FuncParamIn),
+				readOptions,
+				param("changerecord", "change_record", sqlcast.FuncParamOut),
+			},
+		},
+	}
+}
 
-	// TODO: Convert table constraints and other features when needed:
-	// - INTERLEAVE IN PARENT clause for parent-child relationships
-	// - ROW DELETION POLICY for TTL support
-	// - Table-level CHECK constraints
-	// These features are Spanner-specific and may require extending sqlc's AST
+func (c *cc) convertDropChangeStream(n *ast.DropChangeStream) *sqlcast.DropFunctionStmt {
+	return &sqlcast.DropFunctionStmt{
+		Funcs: []*sqlcast.FuncSpec{
+			{Name: changeStreamFuncName(n.Name)},
+		},
+	}
+}
+
+func changeStreamFuncName(name *ast.Ident) *sqlcast.FuncName {
+	return &sqlcast.FuncName{Name: identifier("READ_" + name.Name)}
+}
+
+func (c *cc) convertCreateRole(n *ast.CreateRole) *sqlcast.CreateRoleStmt {
+	name := identifier(n.Name.Name)
+	return &sqlcast.CreateRoleStmt{
+		Role: &name,
+	}
+}
+
+func (c *cc) convertDropRole(n *ast.DropRole) *sqlcast.DropRoleStmt {
+	return &sqlcast.DropRoleStmt{
+		Roles: convertRoleSpecs([]*ast.Ident{n.Name}),
+	}
+}
+
+// GRANT and REVOKE are converted to the PostgreSQL statements they mirror:
+// GRANT ROLE becomes a GrantRoleStmt and every other privilege a GrantStmt.
+//
+// https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#grant_statement
+func (c *cc) convertGrant(priv ast.Privilege, roles []*ast.Ident, isGrant bool) sqlcast.Node {
+	accessPriv := func(name string, cols []*ast.Ident) *sqlcast.AccessPriv {
+		ap := &sqlcast.AccessPriv{PrivName: &name}
+		if len(cols) > 0 {
+			ap.Cols = &sqlcast.List{}
+			for _, col := range cols {
+				ap.Cols.Items = append(ap.Cols.Items, NewIdentifier(col.Name))
+			}
+		}
+		return ap
+	}
+	relations := func(names []*ast.Ident) *sqlcast.List {
+		list := &sqlcast.List{}
+		for _, name := range names {
+			relname := identifier(name.Name)
+			list.Items = append(list.Items, &sqlcast.RangeVar{Relname: &relname})
+		}
+		return list
+	}
+
+	stmt := &sqlcast.GrantStmt{
+		IsGrant:    isGrant,
+		Privileges: &sqlcast.List{},
+		Grantees:   convertRoleSpecs(roles),
+	}
+	switch p := priv.(type) {
+	case *ast.PrivilegeOnTable:
+		stmt.Objtype = sqlcast.GrantObjectTypeTable
+		stmt.Objects = relations(p.Names)
+		for _, tp := range p.Privileges {
+			switch t := tp.(type) {
+			case *ast.SelectPrivilege:
+				stmt.Privileges.Items = append(stmt.Privileges.Items, accessPriv("select", t.Columns))
+			case *ast.InsertPrivilege:
+				stmt.Privileges.Items = append(stmt.Privileges.Items, accessPriv("insert", t.Columns))
+			case *ast.UpdatePrivilege:
+				stmt.Privileges.Items = append(stmt.Privileges.Items, accessPriv("update", t.Columns))
+			case *ast.DeletePrivilege:
+				stmt.Privileges.Items = append(stmt.Privileges.Items, accessPriv("delete", nil))
+			}
+		}
+	case *ast.SelectPrivilegeOnView:
+		stmt.Objtype = sqlcast.GrantObjectTypeView
+		stmt.Objects = relations(p.Names)
+		stmt.Privileges.Items = append(stmt.Privileges.Items, accessPriv("select", nil))
+	case *ast.SelectPrivilegeOnChangeStream:
+		stmt.Objtype = sqlcast.GrantObjectTypeChangeStream
+		stmt.Objects = relations(p.Names)
+		stmt.Privileges.Items = append(stmt.Privileges.Items, accessPriv("select", nil))
+	case *ast.ExecutePrivilegeOnTableFunction:
+		stmt.Objtype = sqlcast.GrantObjectTypeFunction
+		stmt.Objects = &sqlcast.List{}
+		for _, name := range p.Names {
+			stmt.Objects.Items = append(stmt.Objects.Items, &sqlcast.ObjectWithArgs{
+				Objname: &sqlcast.List{Items: []sqlcast.Node{NewIdentifier(name.Name)}},
+			})
+		}
+		stmt.Privileges.Items = append(stmt.Privileges.Items, accessPriv("execute", nil))
+	case *ast.RolePrivilege:
+		granted := &sqlcast.List{}
+		for _, name := range p.Names {
+			granted.Items = append(granted.Items, accessPriv(identifier(name.Name), nil))
+		}
+		return &sqlcast.GrantRoleStmt{
+			GrantedRoles: granted,
+			GranteeRoles: convertRoleSpecs(roles),
+			IsGrant:      isGrant,
+		}
+	default:
+		return c.unsupported(constructName(priv), priv)
+	}
 	return stmt
 }
 
+func convertRoleSpecs(roles []*ast.Ident) *sqlcast.List {
+	list := &sqlcast.List{}
+	for _, role := range roles {
+		name := identifier(role.Name)
+		list.Items = append(list.Items, &sqlcast.RoleSpec{
+			Rolename: &name,
+			Location: int(role.Pos()),
+		})
+	}
+	return list
+}
+
 func (c *cc) convertDropTable(n *ast.DropTable) *sqlcast.DropTableStmt {
 	return &sqlcast.DropTableStmt{
 		IfExists: n.IfExists,
@@ -245,27 +644,27 @@ func (c *cc) convertDropTable(n *ast.DropTable) *sqlcast.DropTableStmt {
 }
 
 func (c *cc) convertCreateIndex(n *ast.CreateIndex) *sqlcast.IndexStmt {
+	idxname := identifier(strings.Join(pathToStrings(n.Name), "."))
 	stmt := &sqlcast.IndexStmt{
-		Idxname:     identifier(strings.Join(pathToStrings(n.Name), ".")),
+		Idxname:     &idxname,
 		Relation:    convertPathToRangeVar(n.TableName),
 		Unique:      n.Unique,
 		IfNotExists: n.IfNotExists,
-		Params:      &sqlcast.List{Items: []sqlcast.Node{}},
+		IndexParams: &sqlcast.List{Items: []sqlcast.Node{}},
 	}
-	
+
 	// Convert index keys to column names
 	for _, key := range n.Keys {
 		if key.Name != nil {
-			// Handle column reference
-			colName := identifier(strings.Join(pathToStrings(key.Name), "."))
-			stmt.Params.Items = append(stmt.Params.Items, &sqlcast.IndexElem{
+			colName := identifier(key.Name.Name)
+			stmt.IndexParams.Items = append(stmt.IndexParams.Items, &sqlcast.IndexElem{
 				Name: &colName,
 				// Spanner supports ASC/DESC in indexes
 				Ordering: convertSortDirection(key.Dir),
 			})
 		}
 	}
-	
+
 	// Note: STORING, INTERLEAVE IN, and OPTIONS are Spanner-specific
 	// and don't have direct equivalents in PostgreSQL's AST
 	if n.Storing != nil && debug.Active {
@@ -274,15 +673,14 @@ func (c *cc) convertCreateIndex(n *ast.CreateIndex) *sqlcast.IndexStmt {
 	if n.InterleaveIn != nil && debug.Active {
 		log.Printf("spanner.convertCreateIndex: INTERLEAVE IN clause not fully supported\n")
 	}
-	
+
 	return stmt
 }
 
 func (c *cc) convertDropIndex(n *ast.DropIndex) *sqlcast.DropStmt {
 	indexName := identifier(strings.Join(pathToStrings(n.Name), "."))
 	return &sqlcast.DropStmt{
-		RemoveType: sqlcast.OBJECT_INDEX,
-		IfExists:   n.IfExists,
+		MissingOk: n.IfExists,
 		Objects: &sqlcast.List{
 			Items: []sqlcast.Node{
 				&sqlcast.String{Str: indexName},
@@ -291,83 +689,121 @@ func (c *cc) convertDropIndex(n *ast.DropIndex) *sqlcast.DropStmt {
 	}
 }
 
-func (c *cc) convertAlterTable(n *ast.AlterTable) *sqlcast.AlterTableStmt {
+func (c *cc) convertAlterTable(n *ast.AlterTable) sqlcast.Node {
+	table := parseTableName(n.Name)
 	stmt := &sqlcast.AlterTableStmt{
-		Table: convertPathToRangeVar(n.Name),
+		Table: table,
 		Cmds:  &sqlcast.List{Items: []sqlcast.Node{}},
 	}
-	
+
 	// Handle different types of table alterations
 	switch alt := n.TableAlteration.(type) {
 	case *ast.AddColumn:
-		for _, col := range alt.Columns {
-			colDef := &sqlcast.ColumnDef{
-				Colname: identifier(col.Name.Name),
-			}
-			// Convert column type
-			if col.Type != nil {
-				colDef.TypeName = convertType(col.Type)
-			}
-			// Handle NOT NULL constraint
-			if col.NotNull {
-				colDef.Constraints = &sqlcast.List{
-					Items: []sqlcast.Node{
-						&sqlcast.Constraint{
-							Contype: sqlcast.CONSTR_NOTNULL,
-						},
-					},
-				}
-			}
-			
-			stmt.Cmds.Items = append(stmt.Cmds.Items, &sqlcast.AlterTableCmd{
-				Subtype: sqlcast.AT_AddColumn,
-				Def:     colDef,
-			})
-		}
+		stmt.Cmds.Items = append(stmt.Cmds.Items, &sqlcast.AlterTableCmd{
+			Subtype:   sqlcast.AT_AddColumn,
+			Def:       c.convertColumnDef(alt.Column),
+			MissingOk: alt.IfNotExists,
+		})
 	case *ast.DropColumn:
-		for _, col := range alt.Names {
-			colName := identifier(col.Name)
+		colName := identifier(alt.Name.Name)
+		stmt.Cmds.Items = append(stmt.Cmds.Items, &sqlcast.AlterTableCmd{
+			Subtype: sqlcast.AT_DropColumn,
+			Name:    &colName,
+		})
+	case *ast.AlterColumn:
+		colName := identifier(alt.Name.Name)
+		switch alteration := alt.Alteration.(type) {
+		case *ast.AlterColumnType:
+			typeName, dims := c.convertColumnType(alteration.Type)
 			stmt.Cmds.Items = append(stmt.Cmds.Items, &sqlcast.AlterTableCmd{
-				Subtype: sqlcast.AT_DropColumn,
+				Subtype: sqlcast.AT_AlterColumnType,
 				Name:    &colName,
+				Def: &sqlcast.ColumnDef{
+					TypeName:  typeName,
+					IsArray:   dims > 0,
+					ArrayDims: dims,
+				},
 			})
-		}
-	case *ast.AlterColumn:
-		// Handle ALTER COLUMN
-		if alt.Name != nil {
-			colName := identifier(alt.Name.Name)
-			cmd := &sqlcast.AlterTableCmd{
-				Name: &colName,
+			// Spanner columns are nullable unless NOT NULL is repeated
+			nullability := sqlcast.AT_DropNotNull
+			if alteration.NotNull {
+				nullability = sqlcast.AT_SetNotNull
 			}
-			
-			// Determine the alteration type
-			if alt.Alteration != nil {
-				switch alteration := alt.Alteration.(type) {
-				case *ast.AlterColumnSetType:
-					cmd.Subtype = sqlcast.AT_AlterColumnType
-					cmd.Def = &sqlcast.ColumnDef{
-						TypeName: convertType(alteration.Type),
-					}
-				case *ast.AlterColumnSetDefault:
-					cmd.Subtype = sqlcast.AT_ColumnDefault
-					// Convert default expression
-				case *ast.AlterColumnDropDefault:
-					cmd.Subtype = sqlcast.AT_DropNotNull
-				}
+			stmt.Cmds.Items = append(stmt.Cmds.Items, &sqlcast.AlterTableCmd{
+				Subtype: nullability,
+				Name:    &colName,
+			})
+		case *ast.AlterColumnSetOptions:
+			if opt := findOption(alteration.Options, "allow_commit_timestamp"); opt != nil {
+				// Setting an option to NULL restores its default
+				lit, _ := opt.Value.(*ast.BoolLiteral)
+				stmt.Cmds.Items = append(stmt.Cmds.Items, &sqlcast.AlterTableCmd{
+					Subtype: sqlcast.AT_SetOptions,
+					Name:    &colName,
+					Def: &sqlcast.ColumnDef{
+						AllowCommitTimestamp: lit != nil && lit.Value,
+					},
+				})
 			}
-			
+		default:
+			// Defaults and identity options don't change the column type
+		}
+	case *ast.RenameTo:
+		newName := identifier(alt.Name.Name)
+		rename := &sqlcast.RenameTableStmt{
+			Table:   table,
+			NewName: &newName,
+		}
+		if alt.AddSynonym == nil {
+			return rename
+		}
+		return &sqlcast.List{
+			Items: []sqlcast.Node{
+				rename,
+				synonymStmt(&sqlcast.TableName{Schema: table.Schema, Name: newName}, sqlcast.AT_AddSynonym, alt.AddSynonym.Name),
+			},
+		}
+	case *ast.AddSynonym:
+		return synonymStmt(table, sqlcast.AT_AddSynonym, alt.Name)
+	case *ast.DropSynonym:
+		return synonymStmt(table, sqlcast.AT_DropSynonym, alt.Name)
+	case *ast.AddTableConstraint:
+		if cmd := c.foreignKeyCmd(alt.TableConstraint); cmd != nil {
 			stmt.Cmds.Items = append(stmt.Cmds.Items, cmd)
 		}
+	case *ast.DropConstraint:
+		name := identifier(alt.Name.Name)
+		stmt.Cmds.Items = append(stmt.Cmds.Items, &sqlcast.AlterTableCmd{
+			Subtype: sqlcast.AT_DropConstraint,
+			Name:    &name,
+		})
+	case *ast.SetInterleaveIn:
+		stmt.Cmds.Items = append(stmt.Cmds.Items, interleaveCmd(alt.TableName))
 	default:
+		// CHECK constraints, row deletion policies and table options don't
+		// change the catalog
 		if debug.Active {
-			log.Printf("spanner.convertAlterTable: Unsupported alteration type %T\n", alt)
+			log.Printf("spanner.convertAlterTable: Ignoring alteration type %T\n", alt)
 		}
 	}
-	
+
 	return stmt
 }
 
-// Helper functions for DDL conversions
+// RENAME TABLE renames each table in turn, which allows swapping names
+// through a temporary one.
+func (c *cc) convertRenameTable(n *ast.RenameTable) *sqlcast.List {
+	list := &sqlcast.List{}
+	for _, to := range n.Tos {
+		newName := identifier(to.New.Name)
+		list.Items = append(list.Items, &sqlcast.RenameTableStmt{
+			Table:   &sqlcast.TableName{Name: identifier(to.Old.Name)},
+			NewName: &newName,
+		})
+	}
+	return list
+}
+
 func pathToStrings(p *ast.Path) []string {
 	if p == nil {
 		return nil
@@ -403,37 +839,31 @@ func convertPathToRangeVar(p *ast.Path) *sqlcast.RangeVar {
 	return rangeVar
 }
 
-func convertSortDirection(dir ast.Dir) sqlcast.SortByDir {
+func convertSortDirection(dir ast.Direction) sqlcast.SortByDir {
 	switch dir {
-	case ast.DirAsc:
-		return sqlcast.SORTBY_ASC
-	case ast.DirDesc:
-		return sqlcast.SORTBY_DESC
+	case ast.DirectionAsc:
+		return sqlcast.SortByDirAsc
+	case ast.DirectionDesc:
+		return sqlcast.SortByDirDesc
 	default:
-		return sqlcast.SORTBY_DEFAULT
+		return sqlcast.SortByDirDefault
 	}
 }
 
 func (c *cc) convertCreateView(n *ast.CreateView) *sqlcast.ViewStmt {
-	viewName := identifier(strings.Join(pathToStrings(n.Name), "."))
 	return &sqlcast.ViewStmt{
-		View: &sqlcast.RangeVar{
-			Relname: &viewName,
-		},
-		Query:   c.convert(n.Query),
-		Replace: n.OrReplace,
+		View:            convertPathToRangeVar(n.Name),
+		Query:           c.convert(n.Query),
+		Replace:         n.OrReplace,
+		SecurityInvoker: n.SecurityType == ast.SecurityTypeInvoker,
 	}
 }
 
-func (c *cc) convertDropView(n *ast.DropView) *sqlcast.DropStmt {
-	viewName := identifier(strings.Join(pathToStrings(n.Name), "."))
-	return &sqlcast.DropStmt{
-		RemoveType: sqlcast.OBJECT_VIEW,
-		IfExists:   n.IfExists,
-		Objects: &sqlcast.List{
-			Items: []sqlcast.Node{
-				&sqlcast.String{Str: viewName},
-			},
+// Views are stored as tables in the catalog, so they are dropped like one.
+func (c *cc) convertDropView(n *ast.DropView) *sqlcast.DropTableStmt {
+	return &sqlcast.DropTableStmt{
+		Tables: []*sqlcast.TableName{
+			parseTableName(n.Name),
 		},
 	}
 }
@@ -450,9 +880,14 @@ func (c *cc) convertInsert(n *ast.Insert) *sqlcast.InsertStmt {
 		ReturningList: &sqlcast.List{Items: []sqlcast.Node{}}, // Must initialize for THEN RETURN support
 	}
 
-	// Convert column names
+	// Convert column names. Target columns are ResTargets, as in PostgreSQL,
+	// so that parameters in VALUES resolve to the column they are inserted into.
 	for _, col := range n.Columns {
-		stmt.Cols.Items = append(stmt.Cols.Items, c.convertIdent(col))
+		name := identifier(col.Name)
+		stmt.Cols.Items = append(stmt.Cols.Items, &sqlcast.ResTarget{
+			Name:     &name,
+			Location: int(col.Pos()) + c.positionOffset,
+		})
 	}
 
 	// Convert input (VALUES)
@@ -503,12 +938,14 @@ func (c *cc) convertUpdate(n *ast.Update) *sqlcast.UpdateStmt {
 
 		if len(item.Path) > 0 && value != nil {
 			// Get the column name from the path
-			colName := item.Path[len(item.Path)-1].Name
+			col := item.Path[len(item.Path)-1]
+			colName := identifier(col.Name)
 
 			// Create ResTarget for the update
 			stmt.TargetList.Items = append(stmt.TargetList.Items, &sqlcast.ResTarget{
-				Name: &colName,
-				Val:  value,
+				Name:     &colName,
+				Val:      value,
+				Location: int(col.Pos()) + c.positionOffset,
 			})
 		}
 	}
@@ -555,7 +992,39 @@ func (c *cc) convertDelete(n *ast.Delete) *sqlcast.DeleteStmt {
 // Query Conversions
 func (c *cc) convertQueryStatement(n *ast.QueryStatement) sqlcast.Node {
 	// QueryStatement wraps a Query
-	return c.convert(n.Query)
+	node := c.convert(n.Query)
+
+	// The LOCK_SCANNED_RANGES=exclusive statement hint takes exclusive locks
+	// on the ranges the query reads, like FOR UPDATE does for its rows
+	if stmt, ok := node.(*sqlcast.SelectStmt); ok && n.Hint != nil {
+		for _, record := range n.Hint.Records {
+			if isExclusiveLockHint(record) {
+				stmt.LockingClause = appendLockingClause(stmt.LockingClause, int(n.Hint.Pos())+c.positionOffset)
+			}
+		}
+	}
+
+	return node
+}
+
+func isExclusiveLockHint(record *ast.HintRecord) bool {
+	key := pathToStrings(record.Key)
+	if len(key) != 1 || !strings.EqualFold(key[0], "LOCK_SCANNED_RANGES") {
+		return false
+	}
+	value, ok := record.Value.(*ast.Ident)
+	return ok && strings.EqualFold(value.Name, "exclusive")
+}
+
+func appendLockingClause(list *sqlcast.List, location int) *sqlcast.List {
+	if list == nil {
+		list = &sqlcast.List{}
+	}
+	list.Items = append(list.Items, &sqlcast.LockingClause{
+		Strength: sqlcast.LockClauseStrengthUpdate,
+		Location: location,
+	})
+	return list
 }
 
 func (c *cc) convertQuery(n *ast.Query) sqlcast.Node {
@@ -587,14 +1056,58 @@ func (c *cc) convertQuery(n *ast.Query) sqlcast.Node {
 		}
 	}
 
+	// Add FOR UPDATE
+	if n.ForUpdate != nil {
+		baseStmt.LockingClause = appendLockingClause(baseStmt.LockingClause, int(n.ForUpdate.Pos())+c.positionOffset)
+	}
+
 	// Handle WITH clause
 	if n.With != nil {
 		baseStmt.WithClause = c.convertWithClause(n.With)
 	}
 
+	if len(n.PipeOperators) > 0 {
+		return c.unsupported("pipe syntax", n.PipeOperators[0])
+	}
+
 	return baseStmt
 }
 
+// convertCompoundQuery folds the queries of a set operation into a left-deep
+// tree, the way the PostgreSQL parser represents
+//
+//	SELECT 1 UNION ALL SELECT 2 UNION ALL SELECT 3
+func (c *cc) convertCompoundQuery(n *ast.CompoundQuery) sqlcast.Node {
+	op := sqlcast.Union
+	switch n.Op {
+	case ast.SetOpIntersect:
+		op = sqlcast.Intersect
+	case ast.SetOpExcept:
+		op = sqlcast.Except
+	}
+	var tree *sqlcast.SelectStmt
+	for _, q := range n.Queries {
+		stmt, ok := c.convert(q).(*sqlcast.SelectStmt)
+		if !ok {
+			// The query was reported as unsupported
+			return &sqlcast.TODO{}
+		}
+		if tree == nil {
+			tree = stmt
+			continue
+		}
+		tree = &sqlcast.SelectStmt{
+			TargetList: &sqlcast.List{Items: []sqlcast.Node{}},
+			FromClause: &sqlcast.List{Items: []sqlcast.Node{}},
+			Larg:       tree,
+			Rarg:       stmt,
+			Op:         op,
+			All:        n.AllOrDistinct == ast.AllOrDistinctAll,
+		}
+	}
+	return tree
+}
+
 func (c *cc) convertSelect(n *ast.Select) *sqlcast.SelectStmt {
 	stmt := &sqlcast.SelectStmt{
 		// CRITICAL: Lists that are always walked must be initialized with Items arrays.
@@ -610,6 +1123,9 @@ func (c *cc) convertSelect(n *ast.Select) *sqlcast.SelectStmt {
 		LimitOffset: nil,                                    // Can be nil - scalar value
 		ValuesLists: nil,                                    // Can be nil - only for VALUES queries
 	}
+	if n.AllOrDistinct == ast.AllOrDistinctDistinct {
+		stmt.DistinctClause = &sqlcast.List{Items: []sqlcast.Node{}}
+	}
 
 	// Handle SELECT AS STRUCT / AS VALUE modifiers
 	// AS STRUCT returns a single STRUCT containing all selected columns
@@ -705,7 +1221,9 @@ func (c *cc) convertSelect(n *ast.Select) *sqlcast.SelectStmt {
 					Fields: &sqlcast.List{
 						Items: fields,
 					},
+					Location: int(i.Expr.Pos()) + c.positionOffset,
 				},
+				Location: int(i.Expr.Pos()) + c.positionOffset,
 			})
 		case *ast.Alias:
 			// Handle alias
@@ -774,6 +1292,7 @@ func (c *cc) convertIdent(n *ast.Ident) *sqlcast.ColumnRef {
 				NewIdentifier(n.Name),
 			},
 		},
+		Location: int(n.Pos()) + c.positionOffset,
 	}
 }
 
@@ -792,7 +1311,8 @@ func (c *cc) convertPath(n *ast.Path) *sqlcast.ColumnRef {
 		items = append(items, NewIdentifier(ident.Name))
 	}
 	return &sqlcast.ColumnRef{
-		Fields: &sqlcast.List{Items: items},
+		Fields:   &sqlcast.List{Items: items},
+		Location: int(n.Pos()) + c.positionOffset,
 	}
 }
 
@@ -800,23 +1320,22 @@ func (c *cc) convertIntLiteral(n *ast.IntLiteral) *sqlcast.A_Const {
 	// Convert string value to int64
 	ival, _ := strconv.ParseInt(n.Value, n.Base, 64)
 	return &sqlcast.A_Const{
-		Val: &sqlcast.Integer{Ival: ival},
+		Val:      &sqlcast.Integer{Ival: ival},
+		Location: int(n.Pos()) + c.positionOffset,
 	}
 }
 
 func (c *cc) convertStringLiteral(n *ast.StringLiteral) *sqlcast.A_Const {
 	return &sqlcast.A_Const{
-		Val: &sqlcast.String{Str: n.Value},
+		Val:      &sqlcast.String{Str: n.Value},
+		Location: int(n.Pos()) + c.positionOffset,
 	}
 }
 
 func (c *cc) convertBoolLiteral(n *ast.BoolLiteral) *sqlcast.A_Const {
-	str := "false"
-	if n.Value {
-		str = "true"
-	}
 	return &sqlcast.A_Const{
-		Val: &sqlcast.String{Str: str},
+		Val:      &sqlcast.Boolean{Boolval: n.Value},
+		Location: int(n.Pos()) + c.positionOffset,
 	}
 }
 
@@ -869,53 +1388,68 @@ func (c *cc) convertCallExpr(n *ast.CallExpr) sqlcast.Node {
 	case "ifnull":
 		// IFNULL(expr, null_result) -> CASE WHEN expr IS NULL THEN null_result ELSE expr END
 		if len(args) == 2 {
-			return c.convertIfNullToCase(args[0], args[1], int(n.Func.Pos()))
+			return c.convertIfNullToCase(args[0], args[1], int(n.Func.Pos())+c.positionOffset)
 		}
 	case "nullif":
 		// NULLIF(expr, expr_to_match) -> CASE WHEN expr = expr_to_match THEN NULL ELSE expr END
 		if len(args) == 2 {
-			return c.convertNullIfToCase(args[0], args[1], int(n.Func.Pos()))
+			return c.convertNullIfToCase(args[0], args[1], int(n.Func.Pos())+c.positionOffset)
 		}
 	case "coalesce":
 		// Use native CoalesceExpr for better type inference
 		if len(args) >= 1 {
 			return &sqlcast.CoalesceExpr{
 				Args:     &sqlcast.List{Items: args},
-				Location: int(n.Func.Pos()),
+				Location: int(n.Func.Pos()) + c.positionOffset,
 			}
 		}
 	}
 
+	// sqlc.arg(), sqlc.narg(), sqlc.slice() and sqlc.embed() are schema
+	// qualified, as they are in the other engines, so that the shared rewrite
+	// and validation passes recognize them.
+	if n.Func != nil && len(n.Func.Idents) == 2 && n.Func.Idents[0].Name == "sqlc" {
+		return &sqlcast.FuncCall{
+			Func: &sqlcast.FuncName{
+				Schema: "sqlc",
+				Name:   strings.ToLower(n.Func.Idents[1].Name),
+			},
+			Args:     &sqlcast.List{Items: args},
+			Location: int(n.Pos()) + c.positionOffset,
+		}
+	}
+
 	funcCall := &sqlcast.FuncCall{
 		Func: &sqlcast.FuncName{
 			Name: funcName,
 		},
-		Args: &sqlcast.List{Items: args},
+		Args:     &sqlcast.List{Items: args},
+		Location: int(n.Pos()) + c.positionOffset,
 	}
 
 	return funcCall
 }
 
 func (c *cc) convertParam(n *ast.Param) sqlcast.Node {
-	// For Spanner, we track parameters by name
-	paramName := n.Name
-
-	// Check if we've seen this parameter before
-	if num, exists := c.paramMap[paramName]; exists {
-		return &sqlcast.ParamRef{
-			Number:   num,
-			Location: int(n.Pos()),
-		}
-	}
-
-	// New parameter - assign it a number
-	c.paramCount++
-	c.paramMap[paramName] = c.paramCount
-	c.paramsByNum[c.paramCount] = paramName
-
-	return &sqlcast.ParamRef{
-		Number:   c.paramCount,
-		Location: int(n.Pos()),
+	// @name is represented the same way the PostgreSQL parser represents its
+	// @name shorthand: an "@" operator applied to a column reference. This lets
+	// rewrite.NamedParameters record the parameter name and assign a single
+	// number to every occurrence of it, while leaving @name in the SQL.
+	return &sqlcast.A_Expr{
+		Name: &sqlcast.List{
+			Items: []sqlcast.Node{
+				&sqlcast.String{Str: "@"},
+			},
+		},
+		Rexpr: &sqlcast.ColumnRef{
+			Fields: &sqlcast.List{
+				Items: []sqlcast.Node{
+					&sqlcast.String{Str: n.Name},
+				},
+			},
+			Location: int(n.Pos()) + c.positionOffset,
+		},
+		Location: int(n.Pos()) + c.positionOffset,
 	}
 }
 
@@ -937,7 +1471,8 @@ func (c *cc) convertTableExpr(n ast.TableExpr) sqlcast.Node {
 	case *ast.TableName:
 		name := identifier(t.Table.Name)
 		rangeVar := &sqlcast.RangeVar{
-			Relname: &name,
+			Relname:  &name,
+			Location: int(t.Pos()) + c.positionOffset,
 		}
 		// Handle table alias
 		if t.As != nil {
@@ -968,16 +1503,55 @@ func (c *cc) convertTableExpr(n ast.TableExpr) sqlcast.Node {
 				Aliasname: &alias,
 			}
 		}
-		// TABLESAMPLE on subquery (runtime sampling only)
-		if t.Sample != nil && debug.Active {
-			log.Printf("spanner.convertTableExpr: TABLESAMPLE on subquery (runtime sampling only)\n")
+		if t.Sample != nil {
+			return c.unsupported("TABLESAMPLE in subquery", t.Sample)
 		}
 		return subquery
 	case *ast.Unnest:
 		// Handle UNNEST in FROM clause
 		return c.convertUnnest(t)
+	case *ast.TVFCallExpr:
+		return c.convertTVFCallExpr(t)
 	default:
-		return todo("convertTableExpr", n)
+		return c.unsupported(constructName(n), n)
+	}
+}
+
+// convertTVFCallExpr converts a table-valued function call, such as ML.PREDICT
+// or the READ_ function of a change stream. MODEL arguments become table
+// names and TABLE arguments range vars, so the compiler can resolve them.
+func (c *cc) convertTVFCallExpr(n *ast.TVFCallExpr) *sqlcast.RangeFunction {
+	var args []sqlcast.Node
+	for _, arg := range n.Args {
+		switch a := arg.(type) {
+		case *ast.ExprArg:
+			args = append(args, c.convert(a.Expr))
+		case *ast.ModelArg:
+			args = append(args, parseTableName(a.Name))
+		case *ast.TableArg:
+			args = append(args, convertTableNameToRangeVar(a.Name))
+		}
+	}
+	for _, arg := range n.NamedArgs {
+		name := identifier(arg.Name.Name)
+		args = append(args, &sqlcast.NamedArgExpr{
+			Name:     &name,
+			Arg:      c.convert(arg.Value),
+			Location: int(arg.Pos()) + c.positionOffset,
+		})
+	}
+	return &sqlcast.RangeFunction{
+		Functions: &sqlcast.List{
+			Items: []sqlcast.Node{
+				&sqlcast.FuncCall{
+					Func: &sqlcast.FuncName{
+						Name: identifier(strings.Join(pathToStrings(n.Name), ".")),
+					},
+					Args:     &sqlcast.List{Items: args},
+					Location: int(n.Pos()) + c.positionOffset,
+				},
+			},
+		},
 	}
 }
 
@@ -1034,7 +1608,8 @@ func (c *cc) convertOrderBy(n *ast.OrderBy) *sqlcast.List {
 	orderList := &sqlcast.List{Items: []sqlcast.Node{}}
 	for _, item := range n.Items {
 		sortBy := &sqlcast.SortBy{
-			Node: c.convert(item.Expr),
+			Node:     c.convert(item.Expr),
+			Location: int(item.Pos()) + c.positionOffset,
 		}
 		if item.Dir != "" {
 			switch item.Dir {
@@ -1075,7 +1650,8 @@ func (c *cc) convertWithClause(n *ast.With) *sqlcast.WithClause {
 func (c *cc) convertValuesInput(n *ast.ValuesInput) *sqlcast.SelectStmt {
 	// Convert VALUES clause to a SELECT statement
 	stmt := &sqlcast.SelectStmt{
-		TargetList:  &sqlcast.List{},
+		TargetList:  &sqlcast.List{Items: []sqlcast.Node{}},
+		FromClause:  &sqlcast.List{Items: []sqlcast.Node{}},
 		ValuesLists: &sqlcast.List{},
 	}
 
@@ -1112,7 +1688,9 @@ func (c *cc) convertThenReturn(n *ast.ThenReturn) *sqlcast.List {
 							&sqlcast.A_Star{},
 						},
 					},
+					Location: int(i.Star) + c.positionOffset,
 				},
+				Location: int(i.Star) + c.positionOffset,
 			})
 		case *ast.Alias:
 			// THEN RETURN expr AS alias -> RETURNING expr AS alias
@@ -1259,7 +1837,7 @@ func (c *cc) convertCaseExpr(n *ast.CaseExpr) *sqlcast.CaseExpr {
 		caseWhen := &sqlcast.CaseWhen{
 			Expr:     c.convert(when.Cond),
 			Result:   c.convert(when.Then),
-			Location: int(when.When) - c.positionOffset,
+			Location: int(when.When) + c.positionOffset,
 		}
 		args = append(args, caseWhen)
 	}
@@ -1274,7 +1852,7 @@ func (c *cc) convertCaseExpr(n *ast.CaseExpr) *sqlcast.CaseExpr {
 		Arg:       c.convert(n.Expr), // The expression after CASE (if any)
 		Args:      &sqlcast.List{Items: args},
 		Defresult: defResult,
-		Location:  int(n.Case) - c.positionOffset,
+		Location:  int(n.Case) + c.positionOffset,
 	}
 }
 
@@ -1286,7 +1864,7 @@ func (c *cc) convertCastExpr(n *ast.CastExpr) *sqlcast.TypeCast {
 	return &sqlcast.TypeCast{
 		Arg:      c.convert(n.Expr),
 		TypeName: c.convertType(n.Type),
-		Location: int(n.Cast) - c.positionOffset,
+		Location: int(n.Cast) + c.positionOffset,
 	}
 }
 
@@ -1301,8 +1879,18 @@ func (c *cc) convertInExpr(n *ast.InExpr) sqlcast.Node {
 	case *ast.ValuesInCondition:
 		// IN (value1, value2, ...)
 		var items []sqlcast.Node
+		var slice bool
 		for _, expr := range cond.Exprs {
-			items = append(items, c.convert(expr))
+			item := c.convert(expr)
+			if call, ok := item.(*sqlcast.FuncCall); ok && call.Func.Schema == "sqlc" && call.Func.Name == "slice" {
+				slice = true
+			}
+			items = append(items, item)
+		}
+		if slice {
+			// IN (sqlc.slice('ids')) is rewritten to IN UNNEST(@ids), see
+			// convertInUnnest.
+			return c.convertInUnnest(n, items, int(cond.Pos()))
 		}
 		right = &sqlcast.List{Items: items}
 	case *ast.SubQueryInCondition:
@@ -1310,9 +1898,9 @@ func (c *cc) convertInExpr(n *ast.InExpr) sqlcast.Node {
 		right = c.convert(cond.Query)
 	case *ast.UnnestInCondition:
 		// IN UNNEST(array_expr)
-		right = c.convert(cond.Expr)
+		return c.convertInUnnest(n, []sqlcast.Node{c.convert(cond.Expr)}, int(cond.Pos()))
 	default:
-		right = todo(cond)
+		right = c.unsupported(constructName(cond), cond)
 	}
 	
 	// Create the appropriate comparison node
@@ -1328,7 +1916,7 @@ func (c *cc) convertInExpr(n *ast.InExpr) sqlcast.Node {
 			},
 			Lexpr:    c.convert(n.Left),
 			Rexpr:    right,
-			Location: -1,
+			Location: int(n.Pos()) + c.positionOffset,
 		}
 	}
 	
@@ -1342,7 +1930,21 @@ func (c *cc) convertInExpr(n *ast.InExpr) sqlcast.Node {
 		},
 		Lexpr:    c.convert(n.Left),
 		Rexpr:    right,
-		Location: -1,
+		Location: int(n.Pos()) + c.positionOffset,
+	}
+}
+
+// convertInUnnest converts IN UNNEST(array_expr) to an ast.In whose list holds
+// the array expression. A parameter in that position is an array of the left
+// hand side's type, and rewrite.NamedParameters marks it as a slice. Location is
+// the start of the UNNEST(...) or (...) condition, which is where the rewrite
+// of sqlc.slice('ids') to UNNEST(@ids) begins.
+func (c *cc) convertInUnnest(n *ast.InExpr, list []sqlcast.Node, pos int) *sqlcast.In {
+	return &sqlcast.In{
+		Expr:     c.convert(n.Left),
+		List:     list,
+		Not:      n.Not,
+		Location: pos + c.positionOffset,
 	}
 }
 
@@ -1361,7 +1963,28 @@ func (c *cc) convertIsNullExpr(n *ast.IsNullExpr) *sqlcast.NullTest {
 	return &sqlcast.NullTest{
 		Arg:          c.convert(n.Left),
 		Nulltesttype: nullTestType,
-		Location:     int(n.Null) - c.positionOffset,
+		Location:     int(n.Null) + c.positionOffset,
+	}
+}
+
+// convertIsBoolExpr converts IS [NOT] TRUE and IS [NOT] FALSE. The test types
+// are numbered like those of the PostgreSQL parser.
+func (c *cc) convertIsBoolExpr(n *ast.IsBoolExpr) *sqlcast.BooleanTest {
+	var testType sqlcast.BoolTestType
+	switch {
+	case n.Right && !n.Not:
+		testType = 1 // IS_TRUE
+	case n.Right && n.Not:
+		testType = 2 // IS_NOT_TRUE
+	case !n.Right && !n.Not:
+		testType = 3 // IS_FALSE
+	default:
+		testType = 4 // IS_NOT_FALSE
+	}
+	return &sqlcast.BooleanTest{
+		Arg:          c.convert(n.Left),
+		Booltesttype: testType,
+		Location:     int(n.RightPos) + c.positionOffset,
 	}
 }
 
@@ -1412,7 +2035,7 @@ func (c *cc) convertUnaryExpr(n *ast.UnaryExpr) sqlcast.Node {
 			Args: &sqlcast.List{
 				Items: []sqlcast.Node{c.convert(n.Expr)},
 			},
-			Location: int(n.OpPos) - c.positionOffset,
+			Location: int(n.OpPos) + c.positionOffset,
 		}
 	case ast.OpPlus, ast.OpMinus:
 		// Unary plus/minus
@@ -1424,7 +2047,7 @@ func (c *cc) convertUnaryExpr(n *ast.UnaryExpr) sqlcast.Node {
 				},
 			},
 			Rexpr:    c.convert(n.Expr),
-			Location: int(n.OpPos) - c.positionOffset,
+			Location: int(n.OpPos) + c.positionOffset,
 		}
 	case ast.OpBitNot:
 		// Bitwise NOT
@@ -1436,10 +2059,10 @@ func (c *cc) convertUnaryExpr(n *ast.UnaryExpr) sqlcast.Node {
 				},
 			},
 			Rexpr:    c.convert(n.Expr),
-			Location: int(n.OpPos) - c.positionOffset,
+			Location: int(n.OpPos) + c.positionOffset,
 		}
 	default:
-		return todo("convertExpr", n)
+		return c.unsupported(fmt.Sprintf("operator %s", n.Op), n)
 	}
 }
 
@@ -1453,8 +2076,9 @@ func (c *cc) convertCountStarExpr(n *ast.CountStarExpr) *sqlcast.FuncCall {
 		Func: &sqlcast.FuncName{
 			Name: "count",
 		},
+		Args:     &sqlcast.List{},
 		AggStar:  true, // This tells sqlc that it's COUNT(*)
-		Location: int(n.Count) - c.positionOffset,
+		Location: int(n.Count) + c.positionOffset,
 	}
 }
 
@@ -1523,7 +2147,7 @@ func (c *cc) convertExtractExpr(n *ast.ExtractExpr) *sqlcast.FuncCall {
 				c.convert(n.Expr),
 			},
 		},
-		Location: int(n.Extract) - c.positionOffset,
+		Location: int(n.Extract) + c.positionOffset,
 	}
 }
 
@@ -1537,7 +2161,7 @@ func (c *cc) convertIfExpr(n *ast.IfExpr) *sqlcast.CaseExpr {
 	caseWhen := &sqlcast.CaseWhen{
 		Expr:     c.convert(n.Expr),
 		Result:   c.convert(n.TrueResult),
-		Location: int(n.If) - c.positionOffset,
+		Location: int(n.If) + c.positionOffset,
 	}
 	
 	return &sqlcast.CaseExpr{
@@ -1546,7 +2170,7 @@ func (c *cc) convertIfExpr(n *ast.IfExpr) *sqlcast.CaseExpr {
 			Items: []sqlcast.Node{caseWhen},
 		},
 		Defresult: c.convert(n.ElseResult),
-		Location:  int(n.If) - c.positionOffset,
+		Location:  int(n.If) + c.positionOffset,
 	}
 }
 
@@ -1645,7 +2269,7 @@ func (c *cc) convertDateLiteral(n *ast.DateLiteral) sqlcast.Node {
 			Val: &sqlcast.String{Str: n.Value.Value},
 		},
 		TypeName: typeName,
-		Location: int(n.Date),
+		Location: int(n.Date) + c.positionOffset,
 	}
 	
 	if debug.Active {
@@ -1667,7 +2291,7 @@ func (c *cc) convertTimestampLiteral(n *ast.TimestampLiteral) sqlcast.Node {
 				},
 			},
 		},
-		Location: int(n.Timestamp),
+		Location: int(n.Timestamp) + c.positionOffset,
 	}
 }
 
@@ -1683,7 +2307,7 @@ func (c *cc) convertNumericLiteral(n *ast.NumericLiteral) sqlcast.Node {
 				},
 			},
 		},
-		Location: int(n.Numeric),
+		Location: int(n.Numeric) + c.positionOffset,
 	}
 }
 
@@ -1699,7 +2323,7 @@ func (c *cc) convertJSONLiteral(n *ast.JSONLiteral) sqlcast.Node {
 				},
 			},
 		},
-		Location: int(n.JSON),
+		Location: int(n.JSON) + c.positionOffset,
 	}
 }
 
@@ -1709,7 +2333,7 @@ func (c *cc) convertScalarSubQuery(n *ast.ScalarSubQuery) sqlcast.Node {
 	return &sqlcast.SubLink{
 		SubLinkType: sqlcast.EXPR_SUBLINK,
 		Subselect:   c.convert(n.Query),
-		Location:    int(n.Lparen),
+		Location:    int(n.Lparen) + c.positionOffset,
 	}
 }
 
@@ -1722,11 +2346,11 @@ func (c *cc) convertArraySubQuery(n *ast.ArraySubQuery) sqlcast.Node {
 				&sqlcast.SubLink{
 					SubLinkType: sqlcast.ARRAY_SUBLINK,
 					Subselect:   c.convert(n.Query),
-					Location:    int(n.Array),
+					Location:    int(n.Array) + c.positionOffset,
 				},
 			},
 		},
-		Location: int(n.Array),
+		Location: int(n.Array) + c.positionOffset,
 	}
 }
 
@@ -1736,7 +2360,7 @@ func (c *cc) convertExistsSubQuery(n *ast.ExistsSubQuery) sqlcast.Node {
 	return &sqlcast.SubLink{
 		SubLinkType: sqlcast.EXISTS_SUBLINK,
 		Subselect:   c.convert(n.Query),
-		Location:    int(n.Exists),
+		Location:    int(n.Exists) + c.positionOffset,
 	}
 }
 
@@ -1807,7 +2431,7 @@ func (c *cc) convertTypedStructLiteral(n *ast.TypedStructLiteral) sqlcast.Node {
 		Args:      &sqlcast.List{Items: args},
 		Colnames:  &sqlcast.List{Items: colnames},
 		RowFormat: sqlcast.CoercionForm(0), // COERCE_EXPLICIT_CALL equivalent
-		Location:  int(n.Struct),
+		Location:  int(n.Struct) + c.positionOffset,
 	}
 }
 
@@ -1877,7 +2501,7 @@ func (c *cc) convertTypelessStructLiteral(n *ast.TypelessStructLiteral) sqlcast.
 				colnames = append(colnames, &sqlcast.String{Str: fieldName})
 			}
 		default:
-			args = append(args, todo(val))
+			args = append(args, c.unsupported(constructName(val), val))
 			colnames = append(colnames, &sqlcast.String{Str: ""})
 		}
 	}
@@ -1886,7 +2510,7 @@ func (c *cc) convertTypelessStructLiteral(n *ast.TypelessStructLiteral) sqlcast.
 		Args:      &sqlcast.List{Items: args},
 		Colnames:  &sqlcast.List{Items: colnames},
 		RowFormat: sqlcast.CoercionForm(0), // COERCE_EXPLICIT_CALL equivalent
-		Location:  int(n.Struct),
+		Location:  int(n.Struct) + c.positionOffset,
 	}
 }
 
@@ -1900,7 +2524,7 @@ func (c *cc) convertTupleStructLiteral(n *ast.TupleStructLiteral) sqlcast.Node {
 	return &sqlcast.RowExpr{
 		Args: &sqlcast.List{Items: args},
 		RowFormat: sqlcast.CoercionForm(1), // COERCE_IMPLICIT_CAST equivalent
-		Location: int(n.Lparen),
+		Location: int(n.Lparen) + c.positionOffset,
 	}
 }
 
@@ -1937,11 +2561,34 @@ func (c *cc) convertIntervalLiteralSingle(n *ast.IntervalLiteralSingle) sqlcast.
 			Val: &sqlcast.String{Str: intervalStr},
 		},
 		TypeName: typeName,
-		Location: int(n.Interval),
+		Location: int(n.Interval) + c.positionOffset,
 	}
 }
 
 func (c *cc) convertSelectorExpr(n *ast.SelectorExpr) sqlcast.Node {
+	if param, ok := n.Expr.(*ast.Param); ok {
+		// @filter.name reads the name field of the STRUCT parameter @filter.
+		// It's represented as the @name shorthand of a qualified column
+		// reference, which rewrite.NamedParameters names "filter.name".
+		return &sqlcast.A_Expr{
+			Name: &sqlcast.List{
+				Items: []sqlcast.Node{
+					&sqlcast.String{Str: "@"},
+				},
+			},
+			Rexpr: &sqlcast.ColumnRef{
+				Fields: &sqlcast.List{
+					Items: []sqlcast.Node{
+						&sqlcast.String{Str: param.Name},
+						&sqlcast.String{Str: n.Ident.Name},
+					},
+				},
+				Location: int(param.Pos()) + c.positionOffset,
+			},
+			Location: int(param.Pos()) + c.positionOffset,
+		}
+	}
+
 	// STRUCT(...).field -> A_Indirection with field name
 	// Convert to A_Indirection to represent field access
 	// 
@@ -1985,11 +2632,7 @@ func (c *cc) convertUnnest(n *ast.Unnest) sqlcast.Node {
 	// Build the UNNEST function call
 	unnestCall := &sqlcast.FuncCall{
 		Func: &sqlcast.FuncName{
-			Names: &sqlcast.List{
-				Items: []sqlcast.Node{
-					&sqlcast.String{Str: "unnest"},
-				},
-			},
+			Name: "unnest",
 		},
 		Args: &sqlcast.List{
 			Items: []sqlcast.Node{
@@ -2066,13 +2709,26 @@ func (c *cc) convertIndexExpr(n *ast.IndexExpr) sqlcast.Node {
 		Indirection: &sqlcast.List{
 			Items: []sqlcast.Node{
 				&sqlcast.A_Indices{
-					Lidx: c.convert(n.Index),
+					Lidx: c.convertSubscriptSpecifier(n.Index),
 				},
 			},
 		},
 	}
 }
 
+func (c *cc) convertSubscriptSpecifier(n ast.SubscriptSpecifier) sqlcast.Node {
+	switch s := n.(type) {
+	case *ast.ExprArg:
+		// array[index]
+		return c.convert(s.Expr)
+	case *ast.SubscriptSpecifierKeyword:
+		// array[OFFSET(n)], array[SAFE_ORDINAL(n)], ...
+		return c.convert(s.Expr)
+	default:
+		return c.unsupported(constructName(n), n)
+	}
+}
+
 func (c *cc) convertIntervalLiteralRange(n *ast.IntervalLiteralRange) sqlcast.Node {
 	// INTERVAL '1-2' YEAR TO MONTH -> TypeCast with interval type
 	typeName := &sqlcast.TypeName{
@@ -2095,6 +2751,6 @@ func (c *cc) convertIntervalLiteralRange(n *ast.IntervalLiteralRange) sqlcast.No
 			Val: &sqlcast.String{Str: intervalStr},
 		},
 		TypeName: typeName,
-		Location: int(n.Interval),
+		Location: int(n.Interval) + c.positionOffset,
 	}
 }
@@ -6,21 +6,14 @@
 // (e.g., @name or sqlc.arg('name')) to positional parameters in the SQL query,
 // the Spanner engine preserves named parameters (@name) in the generated SQL.
 //
-// This is because:
-//  1. Cloud Spanner natively supports named parameters with @ syntax
-//  2. The go-sql-spanner driver can bind positional arguments to named parameters
-//  3. Other drivers (lib/pq, go-sql-driver/mysql) don't support sql.Named()
+// This is because Cloud Spanner natively supports named parameters with @
+// syntax, and a parameter may be referenced any number of times.
 //
-// For consistency with other engines and to minimize changes to the common
-// code generation logic, sqlc currently generates code using positional
-// arguments even for Spanner. This avoids the need for engine-specific
-// parameter handling in the shared codegen layer, relying instead on the
-// go-sql-spanner driver's ability to automatically bind positional arguments
-// to named parameters.
-//
-// This is a pragmatic trade-off for the initial implementation. Future versions
-// may consider more native support for named parameters using sql.Named() once
-// the common codegen layer can better accommodate engine-specific differences.
+// The parser represents @name the same way the PostgreSQL parser represents
+// its @name shorthand, so the shared named parameter rewrite assigns a single
+// number to every occurrence of a name. The Go code generator then binds
+// arguments with sql.Named, once per distinct name, instead of relying on
+// argument order.
 package spanner
 
 // TODO: Future enhancements for Cloud Spanner engine:
@@ -74,7 +67,7 @@ func (p *Parser) splitStatements(filename, content string) ([]statementWithMetad
 	for {
 		err := lexer.NextToken()
 		if err != nil {
-			return nil, convertError(err)
+			return nil, convertError(err, 0)
 		}
 
 		tok := lexer.Token
@@ -164,16 +157,17 @@ func (p *Parser) Parse(r io.Reader) ([]sqlcast.Statement, error) {
 		// Parse the SQL statement
 		node, err := memefish.ParseStatement("<input>", stmt.sql)
 		if err != nil {
-			return nil, convertError(err)
+			return nil, convertError(err, int(stmt.sqlStartPos))
 		}
 
 		converter := &cc{
-			paramMap:    make(map[string]int),
-			paramsByNum: make(map[int]string),
 			// Offset to adjust positions from parsed SQL to original file positions
 			positionOffset: int(stmt.sqlStartPos),
 		}
 		out := converter.convert(node)
+		if converter.err != nil {
+			return nil, converter.err
+		}
 		if _, ok := out.(*sqlcast.TODO); ok {
 			continue
 		}
@@ -199,46 +193,36 @@ func (p *Parser) CommentSyntax() source.CommentSyntax {
 	}
 }
 
-// convertError converts memefish errors to sqlc errors
-func convertError(err error) error {
+// convertError converts memefish errors to sqlc errors. Statements are parsed
+// on their own, so offset is the position of the statement in the file.
+func convertError(err error, offset int) error {
 	if err == nil {
 		return nil
 	}
 
+	// Check if it's a memefish.MultiError type
+	if multiErr, ok := err.(memefish.MultiError); ok && len(multiErr) > 0 {
+		err = multiErr[0]
+	}
+
 	// Check if it's a memefish.Error type
 	if memefishErr, ok := err.(*memefish.Error); ok {
-		line := 1
-		col := 1
-		if memefishErr.Position != nil {
-			// Convert 0-based to 1-based line/column numbers
-			line = memefishErr.Position.Line + 1
-			col = memefishErr.Position.Column + 1
-		}
-		return &sqlerr.Error{
+		e := &sqlerr.Error{
 			Message: "syntax error",
 			Err:     errors.New(memefishErr.Message),
-			Line:    line,
-			Column:  col,
+			Line:    1,
+			Column:  1,
 		}
-	}
-
-	// Check if it's a memefish.MultiError type
-	if multiErr, ok := err.(*memefish.MultiError); ok {
-		if len(*multiErr) > 0 {
-			firstErr := (*multiErr)[0]
-			line := 1
-			col := 1
-			if firstErr.Position != nil {
-				line = firstErr.Position.Line + 1
-				col = firstErr.Position.Column + 1
-			}
-			return &sqlerr.Error{
-				Message: "syntax error",
-				Err:     errors.New(firstErr.Message),
-				Line:    line,
-				Column:  col,
+		if memefishErr.Position != nil {
+			e.Location = int(memefishErr.Position.Pos) + offset
+			if offset == 0 {
+				// Convert 0-based to 1-based line/column numbers, used when
+				// the error is at the very start of the file
+				e.Line = memefishErr.Position.Line + 1
+				e.Column = memefishErr.Position.Column + 1
 			}
 		}
+		return e
 	}
 
 	// For other error types, wrap as-is
@@ -1,10 +1,17 @@
 package spanner
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/named"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 )
 
 func TestParse(t *testing.T) {
@@ -55,6 +62,21 @@ func TestParse(t *testing.T) {
 			input:   "SELECT * FROM users; SELECT * FROM products;",
 			wantErr: false,
 		},
+		{
+			name:    "CREATE ROLE",
+			input:   "CREATE ROLE analyst;",
+			wantErr: false,
+		},
+		{
+			name:    "GRANT",
+			input:   "GRANT SELECT(name), INSERT ON TABLE users TO ROLE analyst;",
+			wantErr: false,
+		},
+		{
+			name:    "CREATE INDEX",
+			input:   "CREATE INDEX users_by_name ON users(name DESC);",
+			wantErr: false,
+		},
 		{
 			name:    "Syntax error",
 			input:   "SELECT FROM users;",
@@ -96,6 +118,115 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseCatalogNoops(t *testing.T) {
+	p := NewParser()
+
+	input := `
+ALTER DATABASE db SET OPTIONS (version_retention_period = '7d');
+CREATE PLACEMENT europe OPTIONS (instance_partition = 'europe-partition');
+CREATE LOCALITY GROUP ssd_only OPTIONS (storage = 'ssd');
+CREATE SEQUENCE user_ids OPTIONS (sequence_kind = 'bit_reversed_positive');
+ALTER CHANGE STREAM everything SET FOR ALL;
+ANALYZE;
+`
+	stmts, err := p.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stmts) != 0 {
+		t.Fatalf("expected no statements, got %d", len(stmts))
+	}
+}
+
+func TestParseRoles(t *testing.T) {
+	p := NewParser()
+
+	input := `
+CREATE TABLE users (id INT64 NOT NULL, name STRING(100)) PRIMARY KEY (id);
+CREATE CHANGE STREAM users_stream FOR users;
+CREATE ROLE analyst;
+CREATE ROLE auditor;
+GRANT SELECT(id, name), UPDATE ON TABLE users TO ROLE analyst, unknown;
+GRANT SELECT ON CHANGE STREAM users_stream TO ROLE analyst;
+GRANT EXECUTE ON TABLE FUNCTION READ_users_stream TO ROLE analyst;
+REVOKE UPDATE ON TABLE users FROM ROLE analyst;
+GRANT ROLE analyst TO ROLE auditor;
+`
+	stmts, err := p.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	analyst, ok := c.GetRole("analyst")
+	if !ok {
+		t.Fatalf("role analyst not found")
+	}
+	want := []*catalog.Privilege{
+		{Name: "select", Objtype: ast.GrantObjectTypeTable, Rel: &ast.TableName{Name: "users"}, Columns: []string{"id", "name"}},
+		{Name: "select", Objtype: ast.GrantObjectTypeChangeStream, Rel: &ast.TableName{Name: "users_stream"}},
+		{Name: "execute", Objtype: ast.GrantObjectTypeFunction, Rel: &ast.TableName{Name: "read_users_stream"}},
+	}
+	if diff := cmp.Diff(want, analyst.Privileges); diff != "" {
+		t.Errorf("analyst privileges mismatch (-want +got):\n%s", diff)
+	}
+
+	auditor, ok := c.GetRole("auditor")
+	if !ok {
+		t.Fatalf("role auditor not found")
+	}
+	if diff := cmp.Diff([]string{"analyst"}, auditor.Roles); diff != "" {
+		t.Errorf("auditor roles mismatch (-want +got):\n%s", diff)
+	}
+	if _, ok := c.GetRole("unknown"); ok {
+		t.Errorf("role unknown should not be created by GRANT")
+	}
+}
+
+func TestParseLockingClause(t *testing.T) {
+	p := NewParser()
+
+	testCases := []struct {
+		input string
+		want  []int
+	}{
+		{"SELECT id FROM users FOR UPDATE;", []int{21}},
+		{"@{LOCK_SCANNED_RANGES=exclusive} SELECT id FROM users;", []int{0}},
+		{"@{lock_scanned_ranges=EXCLUSIVE} SELECT id FROM users FOR UPDATE;", []int{54, 0}},
+		{"@{LOCK_SCANNED_RANGES=shared} SELECT id FROM users;", nil},
+		{"SELECT id FROM users;", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			stmts, err := p.Parse(strings.NewReader(tc.input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			stmt, ok := stmts[0].Raw.Stmt.(*ast.SelectStmt)
+			if !ok {
+				t.Fatalf("expected *ast.SelectStmt, got %T", stmts[0].Raw.Stmt)
+			}
+			var got []int
+			if stmt.LockingClause != nil {
+				for _, item := range stmt.LockingClause.Items {
+					lc := item.(*ast.LockingClause)
+					if lc.Strength != ast.LockClauseStrengthUpdate {
+						t.Errorf("expected FOR UPDATE strength, got %d", lc.Strength)
+					}
+					got = append(got, lc.Location)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("locking clause locations mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestCommentSyntax(t *testing.T) {
 	p := NewParser()
 	syntax := p.CommentSyntax()
@@ -153,6 +284,36 @@ func TestParseWithParams(t *testing.T) {
 	}
 }
 
+func TestParseNamedParams(t *testing.T) {
+	p := NewParser()
+
+	input := "-- name: GetUser :one\nSELECT * FROM users WHERE name = @name OR nickname = @name AND id = @id;"
+	stmts, err := p.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stmts) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(stmts))
+	}
+
+	// Parameters must be recognized by the shared named parameter rewrite,
+	// and their locations must point at the @ sign in the original input.
+	signs := astutils.Search(stmts[0].Raw, named.IsParamSign)
+	var names []string
+	for _, item := range signs.Items {
+		expr := item.(*ast.A_Expr)
+		ref := expr.Rexpr.(*ast.ColumnRef)
+		name := ref.Fields.Items[0].(*ast.String).Str
+		if got := input[expr.Location : expr.Location+len(name)+1]; got != "@"+name {
+			t.Errorf("parameter %s: location points at %q", name, got)
+		}
+		names = append(names, name)
+	}
+	if diff := cmp.Diff([]string{"name", "name", "id"}, names); diff != "" {
+		t.Errorf("parameter names differed (-want +got):\n%s", diff)
+	}
+}
+
 func TestConvertError(t *testing.T) {
 	p := NewParser()
 
@@ -192,3 +353,55 @@ func TestConvertError(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertErrorLocation(t *testing.T) {
+	p := NewParser()
+
+	testCases := []struct {
+		name     string
+		input    string
+		message  string
+		location int
+	}{
+		{
+			name:     "Syntax error in second statement",
+			input:    "SELECT 1;\nSELECT id FROM users WHERE id IN (1,);",
+			message:  "syntax error",
+			location: 46,
+		},
+		{
+			name:     "Unsupported expression",
+			input:    "SELECT 1;\nSELECT WITH(n AS name, CONCAT(n, n)) FROM users;",
+			message:  "WITH expression is not supported",
+			location: 17,
+		},
+		{
+			name:     "TABLESAMPLE in subquery",
+			input:    "SELECT id FROM (SELECT id FROM users) TABLESAMPLE RESERVOIR (10 ROWS);",
+			message:  "TABLESAMPLE in subquery is not supported",
+			location: 38,
+		},
+		{
+			name:     "Pipe syntax",
+			input:    "FROM users |> SELECT id;",
+			message:  "pipe syntax is not supported",
+			location: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := p.Parse(strings.NewReader(tc.input))
+			var serr *sqlerr.Error
+			if !errors.As(err, &serr) {
+				t.Fatalf("expected *sqlerr.Error, got %v", err)
+			}
+			if serr.Message != tc.message {
+				t.Errorf("expected message %q, got %q", tc.message, serr.Message)
+			}
+			if serr.Location != tc.location {
+				t.Errorf("expected location %d, got %d", tc.location, serr.Location)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package spanner
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/sqlc-dev/sqlc/internal/engine/postgresql"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// PostgreSQLParser parses schemas and queries for Spanner databases that use
+// the PostgreSQL interface. It's the PostgreSQL parser, except that features
+// the PostgreSQL interface doesn't support are rejected when they are parsed
+// rather than when the schema is deployed.
+//
+// https://cloud.google.com/spanner/docs/reference/postgresql/overview
+type PostgreSQLParser struct {
+	*postgresql.Parser
+}
+
+func NewPostgreSQLParser() *PostgreSQLParser {
+	return &PostgreSQLParser{postgresql.NewParser()}
+}
+
+func (p *PostgreSQLParser) Parse(r io.Reader) ([]ast.Statement, error) {
+	stmts, err := p.Parser.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, stmt := range stmts {
+		if msg := unsupportedPostgreSQL(stmt.Raw.Stmt); msg != "" {
+			return nil, &sqlerr.Error{
+				Message:  msg + " is not supported by Spanner",
+				Location: stmt.Raw.StmtLocation,
+			}
+		}
+		markCommitTimestamps(stmt.Raw.Stmt)
+	}
+	return stmts, nil
+}
+
+// markCommitTimestamps flags columns of type spanner.commit_timestamp, which
+// is how the PostgreSQL interface spells allow_commit_timestamp = true.
+func markCommitTimestamps(n ast.Node) {
+	var cols []*ast.ColumnDef
+	switch n := n.(type) {
+	case *ast.CreateTableStmt:
+		cols = n.Cols
+	case *ast.AlterTableStmt:
+		for _, item := range n.Cmds.Items {
+			if cmd, ok := item.(*ast.AlterTableCmd); ok && cmd.Def != nil {
+				cols = append(cols, cmd.Def)
+			}
+		}
+	}
+	for _, col := range cols {
+		if col.TypeName != nil && col.TypeName.Schema == "spanner" && col.TypeName.Name == "commit_timestamp" {
+			col.AllowCommitTimestamp = true
+		}
+	}
+}
+
+// unsupportedPostgreSQL describes the PostgreSQL feature used by a statement
+// that Spanner doesn't support, or returns an empty string.
+func unsupportedPostgreSQL(n ast.Node) string {
+	switch n := n.(type) {
+	case *ast.CreateTableStmt:
+		for _, col := range n.Cols {
+			if isSerial(col.TypeName) {
+				return fmt.Sprintf("column %q: %s", col.Colname, col.TypeName.Name)
+			}
+		}
+	case *ast.AlterTableStmt:
+		for _, item := range n.Cmds.Items {
+			cmd, ok := item.(*ast.AlterTableCmd)
+			if !ok || cmd.Def == nil {
+				continue
+			}
+			if isSerial(cmd.Def.TypeName) {
+				return fmt.Sprintf("column %q: %s", cmd.Def.Colname, cmd.Def.TypeName.Name)
+			}
+		}
+	case *ast.CreateTrigStmt:
+		return "CREATE TRIGGER"
+	case *ast.CreateFunctionStmt:
+		return "CREATE FUNCTION"
+	case *ast.CreateEnumStmt:
+		return "CREATE TYPE ... AS ENUM"
+	case *ast.CompositeTypeStmt:
+		return "CREATE TYPE"
+	case *ast.CreateExtensionStmt:
+		return "CREATE EXTENSION"
+	}
+	return ""
+}
+
+// Spanner has no serial types. Keys are generated by a bit-reversed sequence
+// or by the application instead.
+func isSerial(n *ast.TypeName) bool {
+	if n == nil {
+		return false
+	}
+	switch n.Name {
+	case "serial", "serial2", "serial4", "serial8", "smallserial", "bigserial":
+		return true
+	}
+	return false
+}
+
+// NewPostgreSQLCatalog returns the catalog of a Spanner database that uses the
+// PostgreSQL interface: the PostgreSQL catalog, without extensions, and the
+// functions of the spanner schema.
+func NewPostgreSQLCatalog() *catalog.Catalog {
+	c := postgresql.NewCatalog()
+	c.Schemas = append(c.Schemas, spannerPostgreSQLSchema())
+	c.LoadExtension = nil
+	return c
+}
+
+func spannerPostgreSQLSchema() *catalog.Schema {
+	s := &catalog.Schema{Name: "spanner"}
+	s.Funcs = []*catalog.Function{
+		{
+			// Written to a spanner.commit_timestamp column
+			Name:       "pending_commit_timestamp",
+			Args:       []*catalog.Argument{},
+			ReturnType: &ast.TypeName{Name: "timestamptz"},
+		},
+		{
+			Name: "farm_fingerprint",
+			Args: []*catalog.Argument{
+				{Type: &ast.TypeName{Name: "text"}},
+			},
+			ReturnType: &ast.TypeName{Name: "bigint"},
+		},
+		{
+			Name: "farm_fingerprint",
+			Args: []*catalog.Argument{
+				{Type: &ast.TypeName{Name: "bytea"}},
+			},
+			ReturnType: &ast.TypeName{Name: "bigint"},
+		},
+		{
+			Name: "bit_reverse",
+			Args: []*catalog.Argument{
+				{Type: &ast.TypeName{Name: "bigint"}},
+				{Type: &ast.TypeName{Name: "boolean"}},
+			},
+			ReturnType: &ast.TypeName{Name: "bigint"},
+		},
+		{
+			Name:       "generate_uuid",
+			Args:       []*catalog.Argument{},
+			ReturnType: &ast.TypeName{Name: "text"},
+		},
+	}
+	return s
+}
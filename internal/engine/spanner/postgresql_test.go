@@ -0,0 +1,83 @@
+package spanner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+func TestPostgreSQLParse(t *testing.T) {
+	p := NewPostgreSQLParser()
+
+	testCases := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:  "CREATE TABLE",
+			input: "CREATE TABLE users (id bigint PRIMARY KEY, updated_at spanner.commit_timestamp);",
+		},
+		{
+			name:  "CREATE SEQUENCE",
+			input: "CREATE SEQUENCE user_ids;",
+		},
+		{
+			name:    "serial column",
+			input:   "CREATE TABLE users (id serial PRIMARY KEY);",
+			wantErr: `column "id": serial is not supported by Spanner`,
+		},
+		{
+			name:    "serial column added",
+			input:   "ALTER TABLE users ADD COLUMN seq bigserial;",
+			wantErr: `column "seq": bigserial is not supported by Spanner`,
+		},
+		{
+			name:    "CREATE TRIGGER",
+			input:   "CREATE TRIGGER audit AFTER INSERT ON users FOR EACH ROW EXECUTE FUNCTION audit();",
+			wantErr: "CREATE TRIGGER is not supported by Spanner",
+		},
+		{
+			name:    "CREATE TYPE",
+			input:   "CREATE TYPE status AS ENUM ('active', 'inactive');",
+			wantErr: "CREATE TYPE ... AS ENUM is not supported by Spanner",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := p.Parse(strings.NewReader(tc.input))
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error %q, got nil", tc.wantErr)
+			}
+			if err.Error() != tc.wantErr {
+				t.Errorf("expected error %q, got %q", tc.wantErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestPostgreSQLCommitTimestamp(t *testing.T) {
+	p := NewPostgreSQLParser()
+	stmts, err := p.Parse(strings.NewReader("CREATE TABLE users (id bigint PRIMARY KEY, created_at timestamptz, updated_at spanner.commit_timestamp);"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	create, ok := stmts[0].Raw.Stmt.(*ast.CreateTableStmt)
+	if !ok {
+		t.Fatalf("expected *ast.CreateTableStmt, got %T", stmts[0].Raw.Stmt)
+	}
+	for _, col := range create.Cols {
+		want := col.Colname == "updated_at"
+		if col.AllowCommitTimestamp != want {
+			t.Errorf("column %s: AllowCommitTimestamp = %v, want %v", col.Colname, col.AllowCommitTimestamp, want)
+		}
+	}
+}
@@ -0,0 +1,387 @@
+package spanner
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cloudspannerecosystem/memefish"
+	"github.com/cloudspannerecosystem/memefish/ast"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// Schema is a Spanner schema built by applying DDL statements in order, the
+// same way Spanner applies a list of schema updates.
+//
+// Only tables, indexes and views are tracked. Statements that don't change
+// them, such as CREATE SEQUENCE, CREATE CHANGE STREAM, GRANT or DML, are
+// ignored. Statements that do but aren't tracked, such as CREATE SEARCH INDEX,
+// are errors, since a diff that doesn't know about them could drop a column
+// they depend on.
+//
+// The schema keeps the parsed statements rather than a catalog.Catalog, which
+// doesn't record interleaving, options, STORING columns, constraints or the
+// SQL of views.
+type Schema struct {
+	Tables  []*ast.CreateTable
+	Indexes []*ast.CreateIndex
+	Views   []*ast.CreateView
+}
+
+func NewSchema() *Schema {
+	return &Schema{}
+}
+
+// Apply parses contents and applies its statements to the schema. Errors are
+// *sqlerr.Error values located in contents.
+func (s *Schema) Apply(contents string) error {
+	stmts, err := memefish.ParseStatements("<input>", contents)
+	if err != nil {
+		return convertError(err, 0)
+	}
+	for _, stmt := range stmts {
+		if err := s.apply(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Schema) apply(stmt ast.Statement) error {
+	switch n := stmt.(type) {
+	case *ast.CreateTable:
+		if _, t := s.table(pathKey(n.Name)); t != nil {
+			if n.IfNotExists {
+				return nil
+			}
+			return schemaErrorf(n, "table %s already exists", n.Name.SQL())
+		}
+		s.Tables = append(s.Tables, n)
+
+	case *ast.DropTable:
+		i, t := s.table(pathKey(n.Name))
+		if t == nil {
+			if n.IfExists {
+				return nil
+			}
+			return schemaErrorf(n, "table %s does not exist", n.Name.SQL())
+		}
+		s.Tables = slices.Delete(s.Tables, i, i+1)
+
+	case *ast.AlterTable:
+		_, t := s.table(pathKey(n.Name))
+		if t == nil {
+			return schemaErrorf(n, "table %s does not exist", n.Name.SQL())
+		}
+		return s.alterTable(t, n.TableAlteration)
+
+	case *ast.RenameTable:
+		for _, to := range n.Tos {
+			_, t := s.table(strings.ToLower(to.Old.Name))
+			if t == nil {
+				return schemaErrorf(to, "table %s does not exist", to.Old.SQL())
+			}
+			if err := s.renameTable(t, to.New); err != nil {
+				return err
+			}
+		}
+
+	case *ast.CreateIndex:
+		if _, idx := s.index(pathKey(n.Name)); idx != nil {
+			if n.IfNotExists {
+				return nil
+			}
+			return schemaErrorf(n, "index %s already exists", n.Name.SQL())
+		}
+		s.Indexes = append(s.Indexes, n)
+
+	case *ast.DropIndex:
+		i, idx := s.index(pathKey(n.Name))
+		if idx == nil {
+			if n.IfExists {
+				return nil
+			}
+			return schemaErrorf(n, "index %s does not exist", n.Name.SQL())
+		}
+		s.Indexes = slices.Delete(s.Indexes, i, i+1)
+
+	case *ast.AlterIndex:
+		_, idx := s.index(pathKey(n.Name))
+		if idx == nil {
+			return schemaErrorf(n, "index %s does not exist", n.Name.SQL())
+		}
+		switch a := n.IndexAlteration.(type) {
+		case *ast.AddStoredColumn:
+			if idx.Storing == nil {
+				idx.Storing = &ast.Storing{}
+			}
+			idx.Storing.Columns = append(idx.Storing.Columns, a.Name)
+		case *ast.DropStoredColumn:
+			i := -1
+			if idx.Storing != nil {
+				i = identIndex(idx.Storing.Columns, a.Name)
+			}
+			if i < 0 {
+				return schemaErrorf(a, "index %s does not store column %s", n.Name.SQL(), a.Name.SQL())
+			}
+			idx.Storing.Columns = slices.Delete(idx.Storing.Columns, i, i+1)
+			if len(idx.Storing.Columns) == 0 {
+				idx.Storing = nil
+			}
+		}
+
+	case *ast.CreateView:
+		i, v := s.view(pathKey(n.Name))
+		switch {
+		case v == nil:
+			s.Views = append(s.Views, n)
+		case n.OrReplace:
+			s.Views[i] = n
+		default:
+			return schemaErrorf(n, "view %s already exists", n.Name.SQL())
+		}
+
+	case *ast.DropView:
+		i, v := s.view(pathKey(n.Name))
+		if v == nil {
+			return schemaErrorf(n, "view %s does not exist", n.Name.SQL())
+		}
+		s.Views = slices.Delete(s.Views, i, i+1)
+
+	case *ast.CreateSearchIndex, *ast.AlterSearchIndex, *ast.DropSearchIndex:
+		return schemaErrorf(n, "search indexes are not supported by schema diff")
+
+	case *ast.CreateVectorIndex, *ast.AlterVectorIndex, *ast.DropVectorIndex:
+		return schemaErrorf(n, "vector indexes are not supported by schema diff")
+
+	case *ast.CreatePropertyGraph, *ast.DropPropertyGraph:
+		return schemaErrorf(n, "property graphs are not supported by schema diff")
+	}
+	return nil
+}
+
+// renameTable renames a table and the references to it in indexes, interleaved
+// tables and foreign keys, like Spanner does.
+func (s *Schema) renameTable(t *ast.CreateTable, name *ast.Ident) error {
+	key := strings.ToLower(name.Name)
+	if _, other := s.table(key); other != nil {
+		return schemaErrorf(name, "table %s already exists", name.SQL())
+	}
+	for _, other := range s.Tables {
+		if synonymIndex(other, name) >= 0 {
+			return schemaErrorf(name, "%s is already a synonym of table %s", name.SQL(), other.Name.SQL())
+		}
+	}
+
+	old := pathKey(t.Name)
+	t.Name = &ast.Path{Idents: []*ast.Ident{name}}
+	for _, idx := range s.Indexes {
+		if pathKey(idx.TableName) == old {
+			idx.TableName = t.Name
+		}
+	}
+	for _, other := range s.Tables {
+		if other.Cluster != nil && pathKey(other.Cluster.TableName) == old {
+			other.Cluster.TableName = t.Name
+		}
+		for _, c := range other.TableConstraints {
+			if fk, ok := c.Constraint.(*ast.ForeignKey); ok && pathKey(fk.ReferenceTable) == old {
+				fk.ReferenceTable = t.Name
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Schema) alterTable(t *ast.CreateTable, alteration ast.TableAlteration) error {
+	switch a := alteration.(type) {
+	case *ast.AddColumn:
+		if columnIndex(t, a.Column.Name) >= 0 {
+			if a.IfNotExists {
+				return nil
+			}
+			return schemaErrorf(a, "column %s already exists in table %s", a.Column.Name.SQL(), t.Name.SQL())
+		}
+		t.Columns = append(t.Columns, a.Column)
+
+	case *ast.DropColumn:
+		i := columnIndex(t, a.Name)
+		if i < 0 {
+			return schemaErrorf(a, "column %s does not exist in table %s", a.Name.SQL(), t.Name.SQL())
+		}
+		t.Columns = slices.Delete(t.Columns, i, i+1)
+
+	case *ast.AlterColumn:
+		i := columnIndex(t, a.Name)
+		if i < 0 {
+			return schemaErrorf(a, "column %s does not exist in table %s", a.Name.SQL(), t.Name.SQL())
+		}
+		col := t.Columns[i]
+		switch c := a.Alteration.(type) {
+		case *ast.AlterColumnType:
+			col.Type = c.Type
+			col.NotNull = c.NotNull
+			if c.DefaultExpr != nil {
+				col.DefaultSemantics = c.DefaultExpr
+			}
+		case *ast.AlterColumnSetDefault:
+			col.DefaultSemantics = c.DefaultExpr
+		case *ast.AlterColumnDropDefault:
+			col.DefaultSemantics = nil
+		case *ast.AlterColumnSetOptions:
+			col.Options = mergeOptions(col.Options, c.Options)
+		case *ast.AlterColumnAlterIdentity:
+			return schemaErrorf(c, "ALTER IDENTITY is not supported by schema diff")
+		}
+
+	case *ast.AddTableConstraint:
+		t.TableConstraints = append(t.TableConstraints, a.TableConstraint)
+
+	case *ast.DropConstraint:
+		i := constraintIndex(t, a.Name)
+		if i < 0 {
+			return schemaErrorf(a, "constraint %s does not exist in table %s", a.Name.SQL(), t.Name.SQL())
+		}
+		t.TableConstraints = slices.Delete(t.TableConstraints, i, i+1)
+
+	case *ast.AddRowDeletionPolicy:
+		t.RowDeletionPolicy = &ast.CreateRowDeletionPolicy{RowDeletionPolicy: a.RowDeletionPolicy}
+
+	case *ast.ReplaceRowDeletionPolicy:
+		t.RowDeletionPolicy = &ast.CreateRowDeletionPolicy{RowDeletionPolicy: a.RowDeletionPolicy}
+
+	case *ast.DropRowDeletionPolicy:
+		t.RowDeletionPolicy = nil
+
+	case *ast.SetOnDelete:
+		if t.Cluster == nil {
+			return schemaErrorf(a, "table %s is not interleaved", t.Name.SQL())
+		}
+		t.Cluster.OnDelete = a.OnDelete
+
+	case *ast.SetInterleaveIn:
+		t.Cluster = &ast.Cluster{
+			TableName: a.TableName,
+			Enforced:  a.Enforced,
+			OnDelete:  a.OnDelete,
+		}
+
+	case *ast.AlterTableSetOptions:
+		t.Options = mergeOptions(t.Options, a.Options)
+
+	case *ast.AddSynonym:
+		t.Synonyms = append(t.Synonyms, &ast.Synonym{Name: a.Name})
+
+	case *ast.DropSynonym:
+		i := synonymIndex(t, a.Name)
+		if i < 0 {
+			return schemaErrorf(a, "synonym %s does not exist for table %s", a.Name.SQL(), t.Name.SQL())
+		}
+		t.Synonyms = slices.Delete(t.Synonyms, i, i+1)
+
+	case *ast.RenameTo:
+		if err := s.renameTable(t, a.Name); err != nil {
+			return err
+		}
+		if a.AddSynonym != nil {
+			t.Synonyms = append(t.Synonyms, &ast.Synonym{Name: a.AddSynonym.Name})
+		}
+	}
+	return nil
+}
+
+func (s *Schema) table(key string) (int, *ast.CreateTable) {
+	for i, t := range s.Tables {
+		if pathKey(t.Name) == key {
+			return i, t
+		}
+	}
+	return -1, nil
+}
+
+func (s *Schema) index(key string) (int, *ast.CreateIndex) {
+	for i, idx := range s.Indexes {
+		if pathKey(idx.Name) == key {
+			return i, idx
+		}
+	}
+	return -1, nil
+}
+
+func (s *Schema) view(key string) (int, *ast.CreateView) {
+	for i, v := range s.Views {
+		if pathKey(v.Name) == key {
+			return i, v
+		}
+	}
+	return -1, nil
+}
+
+// Spanner names are case-insensitive, so objects are looked up by their
+// lowercased name.
+func pathKey(p *ast.Path) string {
+	parts := make([]string, len(p.Idents))
+	for i, id := range p.Idents {
+		parts[i] = strings.ToLower(id.Name)
+	}
+	return strings.Join(parts, ".")
+}
+
+func identIndex(ids []*ast.Ident, name *ast.Ident) int {
+	return slices.IndexFunc(ids, func(id *ast.Ident) bool {
+		return strings.EqualFold(id.Name, name.Name)
+	})
+}
+
+func columnIndex(t *ast.CreateTable, name *ast.Ident) int {
+	return slices.IndexFunc(t.Columns, func(c *ast.ColumnDef) bool {
+		return strings.EqualFold(c.Name.Name, name.Name)
+	})
+}
+
+func constraintIndex(t *ast.CreateTable, name *ast.Ident) int {
+	return slices.IndexFunc(t.TableConstraints, func(c *ast.TableConstraint) bool {
+		return c.Name != nil && strings.EqualFold(c.Name.Name, name.Name)
+	})
+}
+
+func synonymIndex(t *ast.CreateTable, name *ast.Ident) int {
+	return slices.IndexFunc(t.Synonyms, func(s *ast.Synonym) bool {
+		return strings.EqualFold(s.Name.Name, name.Name)
+	})
+}
+
+// mergeOptions applies SET OPTIONS to existing options. A null value resets
+// an option to its default.
+func mergeOptions(current, set *ast.Options) *ast.Options {
+	var records []*ast.OptionsDef
+	if current != nil {
+		records = slices.Clone(current.Records)
+	}
+	for _, def := range set.Records {
+		i := slices.IndexFunc(records, func(r *ast.OptionsDef) bool {
+			return strings.EqualFold(r.Name.Name, def.Name.Name)
+		})
+		_, null := def.Value.(*ast.NullLiteral)
+		switch {
+		case i >= 0 && null:
+			records = slices.Delete(records, i, i+1)
+		case i >= 0:
+			records[i] = def
+		case !null:
+			records = append(records, def)
+		}
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	return &ast.Options{Records: records}
+}
+
+func schemaErrorf(n ast.Node, format string, args ...any) *sqlerr.Error {
+	return &sqlerr.Error{
+		Message:  fmt.Sprintf(format, args...),
+		Location: int(n.Pos()),
+	}
+}
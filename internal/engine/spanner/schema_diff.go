@@ -0,0 +1,456 @@
+package spanner
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/cloudspannerecosystem/memefish/ast"
+)
+
+// DiffSchema returns the DDL statements that migrate the current schema to
+// the desired one, in an order Spanner accepts: objects that depend on a
+// table are dropped before the table changes and created after it. A view
+// that changes is dropped and created again too, since its old definition may
+// read a column that is dropped.
+//
+// Changes Spanner can't make to an existing schema, such as changing a
+// primary key or moving a table to a different interleave parent, are
+// returned as errors located in the desired schema. No statements are
+// generated for them.
+func DiffSchema(current, desired *Schema) ([]ast.DDL, []error) {
+	d := &schemaDiff{current: current, desired: desired}
+	d.rebuildViews()
+	d.dropViews()
+	d.dropIndexes()
+	d.dropConstraints()
+	d.dropTables()
+	d.alterTables()
+	d.createTables()
+	d.addConstraints()
+	d.createIndexes()
+	d.createViews()
+	return d.stmts, d.errs
+}
+
+type schemaDiff struct {
+	current *Schema
+	desired *Schema
+	stmts   []ast.DDL
+	errs    []error
+
+	// rebuilt holds the views that are dropped and created again
+	rebuilt map[string]bool
+}
+
+func (d *schemaDiff) add(stmt ast.DDL) {
+	d.stmts = append(d.stmts, stmt)
+}
+
+func (d *schemaDiff) alter(t *ast.CreateTable, alteration ast.TableAlteration) {
+	d.add(&ast.AlterTable{Name: t.Name, TableAlteration: alteration})
+}
+
+func (d *schemaDiff) errorf(n ast.Node, format string, args ...any) {
+	d.errs = append(d.errs, schemaErrorf(n, format, args...))
+}
+
+// rebuildViews finds the views that change, and the views that read from them
+// or from a view that is dropped, since Spanner doesn't drop a view another
+// view depends on.
+func (d *schemaDiff) rebuildViews() {
+	d.rebuilt = map[string]bool{}
+	dropped := map[string]bool{}
+	for _, v := range d.current.Views {
+		_, want := d.desired.view(pathKey(v.Name))
+		switch {
+		case want == nil:
+			dropped[pathKey(v.Name)] = true
+		case viewSQL(v) != viewSQL(want):
+			d.rebuilt[pathKey(v.Name)] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, v := range d.current.Views {
+			key := pathKey(v.Name)
+			if dropped[key] || d.rebuilt[key] {
+				continue
+			}
+			for _, name := range viewTables(v) {
+				if dropped[name] || d.rebuilt[name] {
+					d.rebuilt[key] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+// Views are dropped in reverse creation order, so views are dropped before
+// the views they read from.
+func (d *schemaDiff) dropViews() {
+	for i := len(d.current.Views) - 1; i >= 0; i-- {
+		v := d.current.Views[i]
+		_, want := d.desired.view(pathKey(v.Name))
+		if want == nil || d.rebuilt[pathKey(v.Name)] {
+			d.add(&ast.DropView{Name: v.Name})
+		}
+	}
+}
+
+// Views are created after the tables they read from have been updated.
+func (d *schemaDiff) createViews() {
+	for _, want := range d.desired.Views {
+		_, v := d.current.view(pathKey(want.Name))
+		if v == nil || d.rebuilt[pathKey(want.Name)] {
+			create := *want
+			create.OrReplace = false
+			d.add(&create)
+		}
+	}
+}
+
+// Indexes that change are dropped and created again, unless only the
+// STORING clause changes.
+func (d *schemaDiff) dropIndexes() {
+	for _, idx := range d.current.Indexes {
+		_, want := d.desired.index(pathKey(idx.Name))
+		if want == nil || indexSQL(idx) != indexSQL(want) {
+			d.add(&ast.DropIndex{Name: idx.Name})
+		}
+	}
+}
+
+func (d *schemaDiff) createIndexes() {
+	for _, want := range d.desired.Indexes {
+		_, idx := d.current.index(pathKey(want.Name))
+		if idx == nil || indexSQL(idx) != indexSQL(want) {
+			d.add(want)
+			continue
+		}
+		have, wanted := storedColumns(idx), storedColumns(want)
+		for _, col := range have {
+			if identIndex(wanted, col) < 0 {
+				d.add(&ast.AlterIndex{Name: want.Name, IndexAlteration: &ast.DropStoredColumn{Name: col}})
+			}
+		}
+		for _, col := range wanted {
+			if identIndex(have, col) < 0 {
+				d.add(&ast.AlterIndex{Name: want.Name, IndexAlteration: &ast.AddStoredColumn{Name: col}})
+			}
+		}
+	}
+}
+
+// Constraints are compared by name. Spanner generates a name for an unnamed
+// constraint, so one that has been removed from the desired schema can't be
+// dropped by a generated statement.
+func (d *schemaDiff) dropConstraints() {
+	for _, t := range d.current.Tables {
+		_, want := d.desired.table(pathKey(t.Name))
+		if want == nil {
+			continue
+		}
+		for _, c := range t.TableConstraints {
+			if c.Name == nil {
+				if !hasConstraint(want, c) {
+					d.errorf(want, "can't drop unnamed constraint %s from table %s; drop it by its generated name", c.Constraint.SQL(), want.Name.SQL())
+				}
+				continue
+			}
+			i := constraintIndex(want, c.Name)
+			if i < 0 || want.TableConstraints[i].SQL() != c.SQL() {
+				d.alter(t, &ast.DropConstraint{Name: c.Name})
+			}
+		}
+	}
+}
+
+func (d *schemaDiff) addConstraints() {
+	for _, want := range d.desired.Tables {
+		_, t := d.current.table(pathKey(want.Name))
+		if t == nil {
+			continue
+		}
+		for _, c := range want.TableConstraints {
+			if c.Name == nil {
+				if !hasConstraint(t, c) {
+					d.alter(want, &ast.AddTableConstraint{TableConstraint: c})
+				}
+				continue
+			}
+			i := constraintIndex(t, c.Name)
+			if i < 0 || t.TableConstraints[i].SQL() != c.SQL() {
+				d.alter(want, &ast.AddTableConstraint{TableConstraint: c})
+			}
+		}
+	}
+}
+
+// Tables are dropped in reverse creation order, so interleaved tables are
+// dropped before their parents.
+func (d *schemaDiff) dropTables() {
+	for i := len(d.current.Tables) - 1; i >= 0; i-- {
+		t := d.current.Tables[i]
+		if _, want := d.desired.table(pathKey(t.Name)); want == nil {
+			d.add(&ast.DropTable{Name: t.Name})
+		}
+	}
+}
+
+func (d *schemaDiff) createTables() {
+	for _, want := range d.desired.Tables {
+		if _, t := d.current.table(pathKey(want.Name)); t == nil {
+			d.add(want)
+		}
+	}
+}
+
+func (d *schemaDiff) alterTables() {
+	for _, want := range d.desired.Tables {
+		if _, t := d.current.table(pathKey(want.Name)); t != nil {
+			d.alterTable(t, want)
+		}
+	}
+}
+
+func (d *schemaDiff) alterTable(t, want *ast.CreateTable) {
+	if primaryKeySQL(t) != primaryKeySQL(want) {
+		d.errorf(want, "can't change the primary key of table %s; create a new table and copy the data", want.Name.SQL())
+	}
+	d.alterInterleave(t, want)
+
+	for _, col := range t.Columns {
+		if columnIndex(want, col.Name) < 0 {
+			d.alter(t, &ast.DropColumn{Name: col.Name})
+		}
+	}
+	for _, col := range want.Columns {
+		if columnIndex(t, col.Name) >= 0 {
+			continue
+		}
+		if col.NotNull && col.DefaultSemantics == nil {
+			d.errorf(col, "can't add NOT NULL column %s to existing table %s without a DEFAULT", col.Name.SQL(), want.Name.SQL())
+			continue
+		}
+		d.alter(want, &ast.AddColumn{Column: col})
+	}
+	for _, col := range want.Columns {
+		if i := columnIndex(t, col.Name); i >= 0 {
+			d.alterColumn(want, t.Columns[i], col)
+		}
+	}
+
+	switch have, wanted := t.RowDeletionPolicy, want.RowDeletionPolicy; {
+	case have == nil && wanted != nil:
+		d.alter(want, &ast.AddRowDeletionPolicy{RowDeletionPolicy: wanted.RowDeletionPolicy})
+	case have != nil && wanted == nil:
+		d.alter(want, &ast.DropRowDeletionPolicy{})
+	case have != nil && have.SQL() != wanted.SQL():
+		d.alter(want, &ast.ReplaceRowDeletionPolicy{RowDeletionPolicy: wanted.RowDeletionPolicy})
+	}
+
+	for _, syn := range t.Synonyms {
+		if synonymIndex(want, syn.Name) < 0 {
+			d.alter(want, &ast.DropSynonym{Name: syn.Name})
+		}
+	}
+	for _, syn := range want.Synonyms {
+		if synonymIndex(t, syn.Name) < 0 {
+			d.alter(want, &ast.AddSynonym{Name: syn.Name})
+		}
+	}
+
+	if optionsSQL(t.Options) != optionsSQL(want.Options) {
+		d.alter(want, &ast.AlterTableSetOptions{Options: resetOptions(t.Options, want.Options)})
+	}
+}
+
+// Spanner can switch an interleaved table between INTERLEAVE IN and
+// INTERLEAVE IN PARENT and change its ON DELETE action, but it can't move a
+// table to a different parent.
+func (d *schemaDiff) alterInterleave(t, want *ast.CreateTable) {
+	have, wanted := t.Cluster, want.Cluster
+	if have == nil && wanted == nil {
+		return
+	}
+	if have == nil || wanted == nil || pathKey(have.TableName) != pathKey(wanted.TableName) {
+		d.errorf(want, "can't change the interleave parent of table %s; create a new table and copy the data", want.Name.SQL())
+		return
+	}
+	switch {
+	case have.Enforced != wanted.Enforced:
+		d.alter(want, &ast.SetInterleaveIn{
+			TableName: wanted.TableName,
+			Enforced:  wanted.Enforced,
+			OnDelete:  wanted.OnDelete,
+		})
+	case onDeleteAction(have.OnDelete) != onDeleteAction(wanted.OnDelete):
+		d.alter(want, &ast.SetOnDelete{OnDelete: onDeleteAction(wanted.OnDelete)})
+	}
+}
+
+func (d *schemaDiff) alterColumn(t *ast.CreateTable, col, want *ast.ColumnDef) {
+	alter := func(alteration ast.ColumnAlteration) {
+		d.alter(t, &ast.AlterColumn{Name: want.Name, Alteration: alteration})
+	}
+
+	_, haveExpr := defaultExpr(col)
+	wantDefault, wantExpr := defaultExpr(want)
+	if (!haveExpr || !wantExpr) && semanticsSQL(col) != semanticsSQL(want) {
+		d.errorf(want, "can't change the generated value of column %s in table %s; add a new column instead", want.Name.SQL(), t.Name.SQL())
+		return
+	}
+
+	setDefault := semanticsSQL(col) != semanticsSQL(want)
+	if col.Type.SQL() != want.Type.SQL() && !compatibleTypes(col.Type, want.Type) {
+		d.errorf(want.Type, "can't change the type of column %s in table %s from %s to %s", want.Name.SQL(), t.Name.SQL(), col.Type.SQL(), want.Type.SQL())
+	} else if col.Type.SQL() != want.Type.SQL() || col.NotNull != want.NotNull {
+		alter(&ast.AlterColumnType{
+			Type:        want.Type,
+			NotNull:     want.NotNull,
+			DefaultExpr: wantDefault,
+		})
+		setDefault = setDefault && wantDefault == nil
+	}
+	if setDefault {
+		if wantDefault != nil {
+			alter(&ast.AlterColumnSetDefault{DefaultExpr: wantDefault})
+		} else {
+			alter(&ast.AlterColumnDropDefault{})
+		}
+	}
+
+	if optionsSQL(col.Options) != optionsSQL(want.Options) {
+		alter(&ast.AlterColumnSetOptions{Options: resetOptions(col.Options, want.Options)})
+	}
+}
+
+// Spanner can change the length of STRING and BYTES columns and convert
+// between the two. Any other type change needs a new column.
+func compatibleTypes(a, b ast.SchemaType) bool {
+	arrA, okA := a.(*ast.ArraySchemaType)
+	arrB, okB := b.(*ast.ArraySchemaType)
+	if okA != okB {
+		return false
+	}
+	if okA {
+		return compatibleTypes(arrA.Item, arrB.Item)
+	}
+	_, sizedA := a.(*ast.SizedSchemaType)
+	_, sizedB := b.(*ast.SizedSchemaType)
+	return sizedA && sizedB
+}
+
+// defaultExpr returns the column's DEFAULT expression, and whether the
+// column is an ordinary column: one that has a DEFAULT or no default value
+// at all, as opposed to a generated or identity column.
+func defaultExpr(col *ast.ColumnDef) (*ast.ColumnDefaultExpr, bool) {
+	if col.DefaultSemantics == nil {
+		return nil, true
+	}
+	expr, ok := col.DefaultSemantics.(*ast.ColumnDefaultExpr)
+	return expr, ok
+}
+
+func semanticsSQL(col *ast.ColumnDef) string {
+	if col.DefaultSemantics == nil {
+		return ""
+	}
+	return col.DefaultSemantics.SQL()
+}
+
+// indexSQL returns the definition of an index without its STORING clause,
+// which can be changed in place with ALTER INDEX.
+func indexSQL(idx *ast.CreateIndex) string {
+	c := *idx
+	c.IfNotExists = false
+	c.Storing = nil
+	return c.SQL()
+}
+
+func viewSQL(v *ast.CreateView) string {
+	c := *v
+	c.OrReplace = false
+	return c.SQL()
+}
+
+// viewTables returns the lowercased names of the tables and views a view
+// reads from.
+func viewTables(v *ast.CreateView) []string {
+	var names []string
+	ast.Inspect(v.Query, func(n ast.Node) bool {
+		if t, ok := n.(*ast.TableName); ok {
+			names = append(names, strings.ToLower(t.Table.Name))
+		}
+		return true
+	})
+	return names
+}
+
+func storedColumns(idx *ast.CreateIndex) []*ast.Ident {
+	if idx.Storing == nil {
+		return nil
+	}
+	return idx.Storing.Columns
+}
+
+func primaryKeySQL(t *ast.CreateTable) string {
+	var keys []string
+	for _, key := range t.PrimaryKeys {
+		keys = append(keys, indexKeySQL(key.Name, key.Dir))
+	}
+	for _, col := range t.Columns {
+		if col.PrimaryKey {
+			keys = append(keys, indexKeySQL(col.Name, ""))
+		}
+	}
+	return strings.Join(keys, ", ")
+}
+
+func indexKeySQL(name *ast.Ident, dir ast.Direction) string {
+	if dir == "" {
+		dir = ast.DirectionAsc
+	}
+	return strings.ToLower(name.Name) + " " + string(dir)
+}
+
+func hasConstraint(t *ast.CreateTable, c *ast.TableConstraint) bool {
+	return slices.ContainsFunc(t.TableConstraints, func(other *ast.TableConstraint) bool {
+		return other.SQL() == c.SQL()
+	})
+}
+
+func onDeleteAction(action ast.OnDeleteAction) ast.OnDeleteAction {
+	if action == "" {
+		return ast.OnDeleteNoAction
+	}
+	return action
+}
+
+func optionsSQL(o *ast.Options) string {
+	if o == nil {
+		return ""
+	}
+	return o.SQL()
+}
+
+// resetOptions returns the SET OPTIONS list that turns the current options
+// into the desired ones. Options that are no longer set are reset to null.
+func resetOptions(current, desired *ast.Options) *ast.Options {
+	var records []*ast.OptionsDef
+	if desired != nil {
+		records = slices.Clone(desired.Records)
+	}
+	if current != nil {
+		for _, def := range current.Records {
+			if !slices.ContainsFunc(records, func(r *ast.OptionsDef) bool {
+				return strings.EqualFold(r.Name.Name, def.Name.Name)
+			}) {
+				records = append(records, &ast.OptionsDef{Name: def.Name, Value: &ast.NullLiteral{}})
+			}
+		}
+	}
+	return &ast.Options{Records: records}
+}
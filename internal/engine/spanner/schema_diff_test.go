@@ -0,0 +1,310 @@
+package spanner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSchemaApply(t *testing.T) {
+	s := NewSchema()
+	err := s.Apply(`
+CREATE TABLE Singers (SingerId INT64 NOT NULL, Name STRING(100)) PRIMARY KEY (SingerId);
+CREATE INDEX SingersByName ON Singers(Name);
+ALTER TABLE Singers ADD COLUMN Bio STRING(MAX);
+ALTER TABLE Singers DROP COLUMN Name;
+DROP INDEX SingersByName;
+ALTER TABLE Singers RENAME TO Artists;
+CREATE VIEW ArtistIds SQL SECURITY INVOKER AS SELECT SingerId FROM Artists;
+CREATE SEQUENCE Seq OPTIONS (sequence_kind = 'bit_reversed_positive');
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Tables) != 1 || len(s.Indexes) != 0 || len(s.Views) != 1 {
+		t.Fatalf("got %d tables, %d indexes and %d views", len(s.Tables), len(s.Indexes), len(s.Views))
+	}
+	want := "CREATE TABLE Artists (\n  SingerId INT64 NOT NULL,\n  Bio STRING(MAX)\n) PRIMARY KEY (SingerId)"
+	if diff := cmp.Diff(want, s.Tables[0].SQL()); diff != "" {
+		t.Errorf("table mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSchemaApplyError(t *testing.T) {
+	tests := []struct {
+		name string
+		ddl  string
+		err  string
+	}{
+		{
+			name: "missing column",
+			ddl:  "CREATE TABLE T (Id INT64) PRIMARY KEY (Id);\nALTER TABLE T DROP COLUMN Name;",
+			err:  "column Name does not exist in table T",
+		},
+		{
+			name: "rename to existing table",
+			ddl:  "CREATE TABLE A (Id INT64) PRIMARY KEY (Id);\nCREATE TABLE B (Id INT64) PRIMARY KEY (Id);\nRENAME TABLE A TO B;",
+			err:  "table B already exists",
+		},
+		{
+			name: "rename to synonym",
+			ddl:  "CREATE TABLE A (Id INT64) PRIMARY KEY (Id);\nCREATE TABLE B (Id INT64, SYNONYM (C)) PRIMARY KEY (Id);\nALTER TABLE A RENAME TO C;",
+			err:  "C is already a synonym of table B",
+		},
+		{
+			name: "search index",
+			ddl:  "CREATE TABLE T (Id INT64, Body_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Body)) HIDDEN, Body STRING(MAX)) PRIMARY KEY (Id);\nCREATE SEARCH INDEX TByBody ON T(Body_Tokens);",
+			err:  "search indexes are not supported by schema diff",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewSchema().Apply(tc.ddl)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestSchemaApplyRenameTable(t *testing.T) {
+	s := NewSchema()
+	err := s.Apply(`
+CREATE TABLE Singers (SingerId INT64 NOT NULL, Name STRING(100)) PRIMARY KEY (SingerId);
+CREATE TABLE Albums (SingerId INT64 NOT NULL, AlbumId INT64 NOT NULL) PRIMARY KEY (SingerId, AlbumId), INTERLEAVE IN PARENT Singers;
+CREATE TABLE Concerts (Id INT64 NOT NULL, SingerId INT64, CONSTRAINT FK_Singer FOREIGN KEY (SingerId) REFERENCES Singers (SingerId)) PRIMARY KEY (Id);
+CREATE INDEX SingersByName ON Singers(Name);
+RENAME TABLE Singers TO Artists, Albums TO Records;
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, t := range s.Tables {
+		got = append(got, t.SQL())
+	}
+	for _, idx := range s.Indexes {
+		got = append(got, idx.SQL())
+	}
+	want := []string{
+		"CREATE TABLE Artists (\n  SingerId INT64 NOT NULL,\n  Name STRING(100)\n) PRIMARY KEY (SingerId)",
+		"CREATE TABLE Records (\n  SingerId INT64 NOT NULL,\n  AlbumId INT64 NOT NULL\n) PRIMARY KEY (SingerId, AlbumId),\n  INTERLEAVE IN PARENT Artists",
+		"CREATE TABLE Concerts (\n  Id INT64 NOT NULL,\n  SingerId INT64,\n  CONSTRAINT FK_Singer FOREIGN KEY (SingerId) REFERENCES Artists (SingerId)\n) PRIMARY KEY (Id)",
+		"CREATE INDEX SingersByName ON Artists(Name)",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("schema mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiffSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		desired string
+		want    []string
+		errs    []string
+	}{
+		{
+			name:    "no changes",
+			current: "CREATE TABLE T (Id INT64 NOT NULL) PRIMARY KEY (Id);",
+			desired: "create table t (Id INT64 NOT NULL) primary key (Id);",
+		},
+		{
+			name:    "add column and index",
+			current: "CREATE TABLE T (Id INT64 NOT NULL) PRIMARY KEY (Id);",
+			desired: `
+CREATE TABLE T (Id INT64 NOT NULL, Name STRING(100)) PRIMARY KEY (Id);
+CREATE INDEX TByName ON T(Name);`,
+			want: []string{
+				"ALTER TABLE T ADD COLUMN Name STRING(100)",
+				"CREATE INDEX TByName ON T(Name)",
+			},
+		},
+		{
+			name: "drop constraint and index before column",
+			current: `
+CREATE TABLE P (Id INT64 NOT NULL) PRIMARY KEY (Id);
+CREATE TABLE T (Id INT64 NOT NULL, PId INT64, CONSTRAINT FK_P FOREIGN KEY (PId) REFERENCES P (Id)) PRIMARY KEY (Id);
+CREATE INDEX TByP ON T(PId);`,
+			desired: `
+CREATE TABLE P (Id INT64 NOT NULL) PRIMARY KEY (Id);
+CREATE TABLE T (Id INT64 NOT NULL) PRIMARY KEY (Id);`,
+			want: []string{
+				"DROP INDEX TByP",
+				"ALTER TABLE T DROP CONSTRAINT FK_P",
+				"ALTER TABLE T DROP COLUMN PId",
+			},
+		},
+		{
+			name: "drop interleaved tables child first",
+			current: `
+CREATE TABLE P (Id INT64 NOT NULL) PRIMARY KEY (Id);
+CREATE TABLE C (Id INT64 NOT NULL, CId INT64 NOT NULL) PRIMARY KEY (Id, CId), INTERLEAVE IN PARENT P ON DELETE CASCADE;`,
+			desired: "",
+			want: []string{
+				"DROP TABLE C",
+				"DROP TABLE P",
+			},
+		},
+		{
+			name:    "alter column",
+			current: "CREATE TABLE T (Id INT64 NOT NULL, Name STRING(100), Score INT64 DEFAULT (0)) PRIMARY KEY (Id);",
+			desired: "CREATE TABLE T (Id INT64 NOT NULL, Name BYTES(MAX) NOT NULL, Score INT64 DEFAULT (1) OPTIONS (allow_commit_timestamp = true)) PRIMARY KEY (Id);",
+			want: []string{
+				"ALTER TABLE T ALTER COLUMN Name BYTES(MAX) NOT NULL",
+				"ALTER TABLE T ALTER COLUMN Score SET DEFAULT (1)",
+				"ALTER TABLE T ALTER COLUMN Score SET OPTIONS (allow_commit_timestamp = true)",
+			},
+		},
+		{
+			name:    "reset removed options",
+			current: "CREATE TABLE T (Id INT64 NOT NULL, CreatedAt TIMESTAMP OPTIONS (allow_commit_timestamp = true)) PRIMARY KEY (Id);",
+			desired: "CREATE TABLE T (Id INT64 NOT NULL, CreatedAt TIMESTAMP) PRIMARY KEY (Id);",
+			want: []string{
+				"ALTER TABLE T ALTER COLUMN CreatedAt SET OPTIONS (allow_commit_timestamp = null)",
+			},
+		},
+		{
+			name:    "index storing",
+			current: "CREATE TABLE T (Id INT64 NOT NULL, A INT64, B INT64) PRIMARY KEY (Id);\nCREATE INDEX TByA ON T(A) STORING (B);",
+			desired: "CREATE TABLE T (Id INT64 NOT NULL, A INT64, B INT64) PRIMARY KEY (Id);\nCREATE INDEX TByA ON T(A);",
+			want: []string{
+				"ALTER INDEX TByA DROP STORED COLUMN B",
+			},
+		},
+		{
+			name:    "changed index",
+			current: "CREATE TABLE T (Id INT64 NOT NULL, A INT64) PRIMARY KEY (Id);\nCREATE INDEX TByA ON T(A);",
+			desired: "CREATE TABLE T (Id INT64 NOT NULL, A INT64) PRIMARY KEY (Id);\nCREATE UNIQUE INDEX TByA ON T(A DESC);",
+			want: []string{
+				"DROP INDEX TByA",
+				"CREATE UNIQUE INDEX TByA ON T(A DESC)",
+			},
+		},
+		{
+			name: "interleave and row deletion policy",
+			current: `
+CREATE TABLE P (Id INT64 NOT NULL) PRIMARY KEY (Id);
+CREATE TABLE C (Id INT64 NOT NULL, CId INT64 NOT NULL, CreatedAt TIMESTAMP) PRIMARY KEY (Id, CId), INTERLEAVE IN P;`,
+			desired: `
+CREATE TABLE P (Id INT64 NOT NULL) PRIMARY KEY (Id);
+CREATE TABLE C (Id INT64 NOT NULL, CId INT64 NOT NULL, CreatedAt TIMESTAMP) PRIMARY KEY (Id, CId), INTERLEAVE IN PARENT P ON DELETE CASCADE, ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));`,
+			want: []string{
+				"ALTER TABLE C SET INTERLEAVE IN PARENT P ON DELETE CASCADE",
+				"ALTER TABLE C ADD ROW DELETION POLICY ( OLDER_THAN ( CreatedAt, INTERVAL 30 DAY ))",
+			},
+		},
+		{
+			name:    "views",
+			current: "CREATE TABLE T (Id INT64 NOT NULL) PRIMARY KEY (Id);\nCREATE VIEW V SQL SECURITY INVOKER AS SELECT Id FROM T;\nCREATE VIEW Old SQL SECURITY INVOKER AS SELECT 1 AS One;",
+			desired: "CREATE TABLE T (Id INT64 NOT NULL) PRIMARY KEY (Id);\nCREATE VIEW V SQL SECURITY INVOKER AS SELECT T.Id FROM T;",
+			want: []string{
+				"DROP VIEW Old",
+				"DROP VIEW V",
+				"CREATE VIEW V SQL SECURITY INVOKER AS SELECT T.Id FROM T",
+			},
+		},
+		{
+			name: "views around dropped column",
+			current: `
+CREATE TABLE T (Id INT64 NOT NULL, Name STRING(MAX)) PRIMARY KEY (Id);
+CREATE VIEW V SQL SECURITY INVOKER AS SELECT Id, Name FROM T;
+CREATE VIEW W SQL SECURITY INVOKER AS SELECT Id FROM V;
+CREATE VIEW X SQL SECURITY INVOKER AS SELECT Id FROM T;`,
+			desired: `
+CREATE TABLE T (Id INT64 NOT NULL) PRIMARY KEY (Id);
+CREATE VIEW V SQL SECURITY INVOKER AS SELECT Id FROM T;
+CREATE VIEW W SQL SECURITY INVOKER AS SELECT Id FROM V;
+CREATE VIEW X SQL SECURITY INVOKER AS SELECT Id FROM T;`,
+			want: []string{
+				"DROP VIEW W",
+				"DROP VIEW V",
+				"ALTER TABLE T DROP COLUMN Name",
+				"CREATE VIEW V SQL SECURITY INVOKER AS SELECT Id FROM T",
+				"CREATE VIEW W SQL SECURITY INVOKER AS SELECT Id FROM V",
+			},
+		},
+		{
+			name: "renamed table",
+			current: `
+CREATE TABLE Singers (Id INT64 NOT NULL, Name STRING(MAX)) PRIMARY KEY (Id);
+CREATE TABLE Albums (Id INT64 NOT NULL, AlbumId INT64 NOT NULL) PRIMARY KEY (Id, AlbumId), INTERLEAVE IN PARENT Singers ON DELETE CASCADE;
+CREATE INDEX SingersByName ON Singers(Name);
+RENAME TABLE Singers TO Artists;`,
+			desired: `
+CREATE TABLE Artists (Id INT64 NOT NULL, Name STRING(MAX)) PRIMARY KEY (Id);
+CREATE TABLE Albums (Id INT64 NOT NULL, AlbumId INT64 NOT NULL) PRIMARY KEY (Id, AlbumId), INTERLEAVE IN PARENT Artists ON DELETE CASCADE;
+CREATE INDEX SingersByName ON Artists(Name);`,
+		},
+		{
+			name: "renamed table with synonym",
+			current: `
+CREATE TABLE Singers (Id INT64 NOT NULL) PRIMARY KEY (Id);
+ALTER TABLE Singers RENAME TO Artists, ADD SYNONYM Singers;`,
+			desired: "CREATE TABLE Artists (Id INT64 NOT NULL, SYNONYM (Singers)) PRIMARY KEY (Id);",
+		},
+		{
+			name:    "primary key change",
+			current: "CREATE TABLE T (Id INT64 NOT NULL, Other INT64 NOT NULL) PRIMARY KEY (Id);",
+			desired: "CREATE TABLE T (Id INT64 NOT NULL, Other INT64 NOT NULL) PRIMARY KEY (Id, Other);",
+			errs: []string{
+				"can't change the primary key of table T; create a new table and copy the data",
+			},
+		},
+		{
+			name: "interleave parent change",
+			current: `
+CREATE TABLE P (Id INT64 NOT NULL) PRIMARY KEY (Id);
+CREATE TABLE C (Id INT64 NOT NULL) PRIMARY KEY (Id);`,
+			desired: `
+CREATE TABLE P (Id INT64 NOT NULL) PRIMARY KEY (Id);
+CREATE TABLE C (Id INT64 NOT NULL) PRIMARY KEY (Id), INTERLEAVE IN PARENT P;`,
+			errs: []string{
+				"can't change the interleave parent of table C; create a new table and copy the data",
+			},
+		},
+		{
+			name:    "unsupported column changes",
+			current: "CREATE TABLE T (Id INT64 NOT NULL, A INT64, B INT64 AS (Id + 1) STORED) PRIMARY KEY (Id);",
+			desired: "CREATE TABLE T (Id INT64 NOT NULL, A STRING(MAX), B INT64 AS (Id + 2) STORED, C INT64 NOT NULL) PRIMARY KEY (Id);",
+			errs: []string{
+				"can't add NOT NULL column C to existing table T without a DEFAULT",
+				"can't change the type of column A in table T from INT64 to STRING(MAX)",
+				"can't change the generated value of column B in table T; add a new column instead",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			current := NewSchema()
+			if err := current.Apply(tc.current); err != nil {
+				t.Fatal(err)
+			}
+			desired := NewSchema()
+			if err := desired.Apply(tc.desired); err != nil {
+				t.Fatal(err)
+			}
+			stmts, errs := DiffSchema(current, desired)
+			var got []string
+			for _, stmt := range stmts {
+				got = append(got, stmt.SQL())
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("statements mismatch (-want +got):\n%s", diff)
+			}
+			var gotErrs []string
+			for _, err := range errs {
+				gotErrs = append(gotErrs, err.Error())
+			}
+			if diff := cmp.Diff(tc.errs, gotErrs); diff != "" {
+				t.Errorf("errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -38,6 +38,46 @@ func TestUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			`
+			CREATE TABLE foo (bar integer PRIMARY KEY, baz text);
+			CREATE TABLE qux (a text, b text, PRIMARY KEY (a, b));
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel: &ast.TableName{Name: "foo"},
+						Columns: []*catalog.Column{
+							{
+								Name:      "bar",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+							},
+							{
+								Name: "baz",
+								Type: ast.TypeName{Name: "text"},
+							},
+						},
+						PrimaryKey: []string{"bar"},
+					},
+					{
+						Rel: &ast.TableName{Name: "qux"},
+						Columns: []*catalog.Column{
+							{
+								Name: "a",
+								Type: ast.TypeName{Name: "text"},
+							},
+							{
+								Name: "b",
+								Type: ast.TypeName{Name: "text"},
+							},
+						},
+						PrimaryKey: []string{"a", "b"},
+					},
+				},
+			},
+		},
 		{
 			`
 			CREATE TABLE foo (bar text);
@@ -122,6 +122,20 @@ func (c *cc) convertCreate_table_stmtContext(n *parser.Create_table_stmtContext)
 				IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
 				TypeName:  &ast.TypeName{Name: typeName},
 			})
+			if hasPrimaryKeyConstraint(def.AllColumn_constraint()) {
+				stmt.PrimaryKey = append(stmt.PrimaryKey, identifier(def.Column_name().GetText()))
+			}
+		}
+	}
+	for _, icon := range n.AllTable_constraint() {
+		con, ok := icon.(*parser.Table_constraintContext)
+		if !ok || con.PRIMARY_() == nil {
+			continue
+		}
+		for _, icol := range con.AllIndexed_column() {
+			if col, ok := icol.(*parser.Indexed_columnContext); ok && col.Column_name() != nil {
+				stmt.PrimaryKey = append(stmt.PrimaryKey, identifier(col.Column_name().GetText()))
+			}
 		}
 	}
 	return stmt
@@ -511,6 +525,11 @@ func (c *cc) convertMultiSelect_stmtContext(n *parser.Select_stmtContext) ast.No
 		}
 	}
 
+	if n.Order_by_stmt() != nil {
+		if sortClause, ok := c.convertOrderby_stmtContext(n.Order_by_stmt()).(*ast.List); ok {
+			selectStmt.SortClause = sortClause
+		}
+	}
 	limitCount, limitOffset := c.convertLimit_stmtContext(n.Limit_stmt())
 	selectStmt.LimitCount = limitCount
 	selectStmt.LimitOffset = limitOffset
@@ -20,6 +20,16 @@ func parseTableName(c tableNamer) *ast.TableName {
 	return &name
 }
 
+func hasPrimaryKeyConstraint(checks []parser.IColumn_constraintContext) bool {
+	for i := range checks {
+		constraint, ok := checks[i].(*parser.Column_constraintContext)
+		if ok && constraint.PRIMARY_() != nil && constraint.KEY_() != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func hasNotNullConstraint(checks []parser.IColumn_constraintContext) bool {
 	for i := range checks {
 		constraint, ok := checks[i].(*parser.Column_constraintContext)
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/sqlc-dev/sqlc/internal/constants"
+	"regexp"
 	"strings"
 	"unicode"
 
@@ -23,6 +24,9 @@ type Metadata struct {
 	// If the map is empty, but the disable vet flag is specified, then all rules are ignored.
 	RuleSkiplist map[string]struct{}
 
+	// Keyset holds the columns of a @paginate keyset(...) annotation
+	Keyset []string
+
 	Filename string
 }
 
@@ -37,6 +41,11 @@ const (
 	CmdBatchExec  = ":batchexec"
 	CmdBatchMany  = ":batchmany"
 	CmdBatchOne   = ":batchone"
+
+	// CmdPartitioned reads a Spanner query in parallel with PartitionQuery
+	CmdPartitioned = ":partitioned"
+	// CmdIter streams rows through an iter.Seq2 instead of buffering them
+	CmdIter = ":iter"
 )
 
 // A query name must be a valid Go identifier
@@ -106,7 +115,7 @@ func ParseQueryNameAndType(t string, commentStyle CommentSyntax) (string, string
 		queryName := part[2]
 		queryType := strings.TrimSpace(part[3])
 		switch queryType {
-		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId, CmdCopyFrom, CmdBatchExec, CmdBatchMany, CmdBatchOne:
+		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId, CmdCopyFrom, CmdBatchExec, CmdBatchMany, CmdBatchOne, CmdPartitioned, CmdIter:
 		default:
 			return "", "", fmt.Errorf("invalid query type: %s", queryType)
 		}
@@ -170,3 +179,37 @@ func ParseCommentFlags(comments []string) (map[string]string, map[string]bool, m
 
 	return params, flags, ruleSkiplist, nil
 }
+
+var keysetAnnotation = regexp.MustCompile(`^keyset\((.*)\)$`)
+
+// ParseKeyset returns the columns of a `@paginate keyset(col, ...)` annotation,
+// or nil when the query has none.
+func ParseKeyset(comments []string) ([]string, error) {
+	var keyset []string
+	for _, line := range comments {
+		rest, ok := strings.CutPrefix(strings.TrimSpace(line), constants.QueryFlagPaginate)
+		if !ok || (rest != "" && !unicode.IsSpace(rune(rest[0]))) {
+			continue
+		}
+		if keyset != nil {
+			return nil, fmt.Errorf("%s can only be used once", constants.QueryFlagPaginate)
+		}
+		m := keysetAnnotation.FindStringSubmatch(strings.TrimSpace(rest))
+		if m == nil {
+			return nil, fmt.Errorf("invalid %s annotation %q: expected keyset(column, ...)", constants.QueryFlagPaginate, strings.TrimSpace(rest))
+		}
+		seen := map[string]bool{}
+		for _, col := range strings.Split(m[1], ",") {
+			col = strings.TrimSpace(col)
+			if validateQueryName(col) != nil {
+				return nil, fmt.Errorf("invalid %s keyset column %q", constants.QueryFlagPaginate, col)
+			}
+			if seen[strings.ToLower(col)] {
+				return nil, fmt.Errorf("%s keyset column %q is repeated", constants.QueryFlagPaginate, col)
+			}
+			seen[strings.ToLower(col)] = true
+			keyset = append(keyset, col)
+		}
+	}
+	return keyset, nil
+}
@@ -180,3 +180,47 @@ func TestParseQueryRuleSkiplist(t *testing.T) {
 		}
 	}
 }
+
+func TestParseKeyset(t *testing.T) {
+	for _, comments := range [][]string{
+		{
+			" name: ListFoos :many",
+			" @paginate keyset(created_at, id)",
+		},
+		{
+			" name: ListFoos :many ",
+			"@paginate keyset( created_at,id ) ",
+		},
+		{
+			" name: ListFoos :many",
+			" @param foo_id UUID",
+			" @paginate   keyset(created_at , id)",
+		},
+	} {
+		keyset, err := ParseKeyset(comments)
+		if err != nil {
+			t.Errorf("expected comments to parse, got err: %s", err)
+		}
+		if len(keyset) != 2 || keyset[0] != "created_at" || keyset[1] != "id" {
+			t.Errorf("unexpected keyset: %q", keyset)
+		}
+	}
+
+	keyset, err := ParseKeyset([]string{" name: ListFoos :many", " @paginated"})
+	if err != nil || keyset != nil {
+		t.Errorf("unexpected keyset: %q, %v", keyset, err)
+	}
+
+	for _, comments := range [][]string{
+		{" @paginate"},
+		{" @paginate keyset()"},
+		{" @paginate offset(id)"},
+		{" @paginate keyset(created_at, )"},
+		{" @paginate keyset(id, ID)"},
+		{" @paginate keyset(id)", " @paginate keyset(id)"},
+	} {
+		if _, err := ParseKeyset(comments); err == nil {
+			t.Errorf("expected invalid annotation: %q", comments)
+		}
+	}
+}
@@ -15,6 +15,10 @@ type Edit struct {
 	OldFunc  func(string) int
 }
 
+func (e Edit) isInsert() bool {
+	return e.Old == "" && e.OldFunc == nil
+}
+
 type CommentSyntax struct {
 	Dash      bool
 	Hash      bool
@@ -62,7 +66,14 @@ func Mutate(raw string, a []Edit) (string, error) {
 		return raw, nil
 	}
 
-	sort.Slice(a, func(i, j int) bool { return a[i].Location > a[j].Location })
+	// An insertion is applied after an edit at the same location, so that it
+	// ends up in front of it
+	sort.SliceStable(a, func(i, j int) bool {
+		if a[i].Location == a[j].Location {
+			return !a[i].isInsert() && a[j].isInsert()
+		}
+		return a[i].Location > a[j].Location
+	})
 
 	s := raw
 	for idx, edit := range a {
@@ -111,6 +111,10 @@ func TestMutateMulti(t *testing.T) {
 
 		// lengthening edits
 		{"abcde", newEdit(1, "b", "BBBB"), newEdit(2, "c", "CCCC"), "aBBBBCCCCde"},
+
+		// insertions next to an edit
+		{"abcde", newEdit(1, "", "_"), newEdit(1, "b", "B"), "a_Bcde"},
+		{"abcde", newEdit(2, "", "_"), newEdit(1, "b", "B"), "aB_cde"},
 	}
 
 	origTests := tests
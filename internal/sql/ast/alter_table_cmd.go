@@ -6,6 +6,12 @@ const (
 	AT_DropColumn
 	AT_DropNotNull
 	AT_SetNotNull
+	AT_SetOptions
+	AT_AddSynonym
+	AT_DropSynonym
+	AT_AddConstraint
+	AT_DropConstraint
+	AT_SetInterleaveIn
 )
 
 type AlterTableType int
@@ -22,6 +28,18 @@ func (t AlterTableType) String() string {
 		return "DropNotNull"
 	case AT_SetNotNull:
 		return "SetNotNull"
+	case AT_SetOptions:
+		return "SetOptions"
+	case AT_AddSynonym:
+		return "AddSynonym"
+	case AT_DropSynonym:
+		return "DropSynonym"
+	case AT_AddConstraint:
+		return "AddConstraint"
+	case AT_DropConstraint:
+		return "DropConstraint"
+	case AT_SetInterleaveIn:
+		return "SetInterleaveIn"
 	default:
 		return "Unknown"
 	}
@@ -34,6 +52,11 @@ type AlterTableCmd struct {
 	Newowner  *RoleSpec
 	Behavior  DropBehavior
 	MissingOk bool
+
+	// The FOREIGN KEY added by AT_AddConstraint
+	Constraint *Constraint
+	// The parent table of AT_SetInterleaveIn
+	Parent *TableName
 }
 
 func (n *AlterTableCmd) Pos() int {
@@ -11,6 +11,9 @@ type ColumnDef struct {
 	Length     *int
 	PrimaryKey bool
 
+	// Spanner columns that accept PENDING_COMMIT_TIMESTAMP()
+	AllowCommitTimestamp bool
+
 	// From pg.ColumnDef
 	Inhcount      int
 	IsLocal       bool
@@ -0,0 +1,16 @@
+package ast
+
+// CreateModelStmt registers a machine learning model, as created by Spanner's
+// CREATE MODEL statement. Inputs and Outputs describe the columns the model
+// reads and the columns of its predictions.
+type CreateModelStmt struct {
+	Name        *TableName
+	Replace     bool
+	IfNotExists bool
+	Inputs      []*ColumnDef
+	Outputs     []*ColumnDef
+}
+
+func (n *CreateModelStmt) Pos() int {
+	return 0
+}
@@ -7,6 +7,8 @@ type CreateTableStmt struct {
 	ReferTable  *TableName
 	Comment     string
 	Inherits    []*TableName
+	// Columns of the primary key, when the engine records it
+	PrimaryKey []string
 }
 
 func (n *CreateTableStmt) Pos() int {
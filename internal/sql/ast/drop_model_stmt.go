@@ -0,0 +1,10 @@
+package ast
+
+type DropModelStmt struct {
+	IfExists bool
+	Models   []*TableName
+}
+
+func (n *DropModelStmt) Pos() int {
+	return 0
+}
@@ -1,5 +1,16 @@
 package ast
 
+// GrantObjectType is the kind of object named in a GRANT or REVOKE
+// Enum copies https://github.com/pganalyze/libpg_query/blob/16-latest/protobuf/pg_query.proto
+const (
+	GrantObjectTypeFunction GrantObjectType = 20
+	GrantObjectTypeTable    GrantObjectType = 42
+	GrantObjectTypeView     GrantObjectType = 52
+
+	// Spanner change streams have no PostgreSQL equivalent
+	GrantObjectTypeChangeStream GrantObjectType = 1000
+)
+
 type GrantObjectType uint
 
 func (n *GrantObjectType) Pos() int {
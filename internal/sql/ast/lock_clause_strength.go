@@ -5,3 +5,12 @@ type LockClauseStrength uint
 func (n *LockClauseStrength) Pos() int {
 	return 0
 }
+
+const (
+	LockClauseStrengthUndefined   LockClauseStrength = 0
+	LockClauseStrengthNone        LockClauseStrength = 1
+	LockClauseStrengthKeyShare    LockClauseStrength = 2
+	LockClauseStrengthShare       LockClauseStrength = 3
+	LockClauseStrengthNoKeyUpdate LockClauseStrength = 4
+	LockClauseStrengthUpdate      LockClauseStrength = 5
+)
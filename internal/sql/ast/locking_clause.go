@@ -4,10 +4,11 @@ type LockingClause struct {
 	LockedRels *List
 	Strength   LockClauseStrength
 	WaitPolicy LockWaitPolicy
+	Location   int
 }
 
 func (n *LockingClause) Pos() int {
-	return 0
+	return n.Location
 }
 
 func (n *LockingClause) Format(buf *TrackedBuffer) {
@@ -16,9 +17,9 @@ func (n *LockingClause) Format(buf *TrackedBuffer) {
 	}
 	buf.WriteString("FOR ")
 	switch n.Strength {
-	case 3:
+	case LockClauseStrengthShare:
 		buf.WriteString("SHARE")
-	case 5:
+	case LockClauseStrengthUpdate:
 		buf.WriteString("UPDATE")
 	}
 }
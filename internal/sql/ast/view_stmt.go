@@ -7,6 +7,9 @@ type ViewStmt struct {
 	Replace         bool
 	Options         *List
 	WithCheckOption ViewCheckOption
+
+	// Spanner views declared SQL SECURITY INVOKER
+	SecurityInvoker bool
 }
 
 func (n *ViewStmt) Pos() int {
@@ -167,12 +167,18 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.CommentOnViewStmt:
 		a.apply(n, "View", nil, n.View)
 
+	case *ast.CreateModelStmt:
+		a.apply(n, "Name", nil, n.Name)
+
 	case *ast.CreateTableStmt:
 		a.apply(n, "Name", nil, n.Name)
 
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropModelStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
@@ -365,6 +371,8 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.AlterTableCmd:
 		a.apply(n, "Newowner", nil, n.Newowner)
 		a.apply(n, "Def", nil, n.Def)
+		a.apply(n, "Constraint", nil, n.Constraint)
+		a.apply(n, "Parent", nil, n.Parent)
 
 	case *ast.AlterTableMoveAllStmt:
 		a.apply(n, "Roles", nil, n.Roles)
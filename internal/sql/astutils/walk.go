@@ -87,6 +87,11 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.TypeName)
 		}
 
+	case *ast.CreateModelStmt:
+		if n.Name != nil {
+			Walk(f, n.Name)
+		}
+
 	case *ast.CreateTableStmt:
 		if n.Name != nil {
 			Walk(f, n.Name)
@@ -95,6 +100,9 @@ func Walk(f Visitor, node ast.Node) {
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropModelStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
@@ -428,6 +436,12 @@ func Walk(f Visitor, node ast.Node) {
 		if n.Def != nil {
 			Walk(f, n.Def)
 		}
+		if n.Constraint != nil {
+			Walk(f, n.Constraint)
+		}
+		if n.Parent != nil {
+			Walk(f, n.Parent)
+		}
 
 	case *ast.AlterTableMoveAllStmt:
 		if n.Roles != nil {
@@ -9,6 +9,7 @@ type Catalog struct {
 	Comment       string
 	DefaultSchema string
 	Name          string
+	Roles         []*Role
 	Schemas       []*Schema
 	SearchPath    []string
 	LoadExtension func(string) *Schema
@@ -91,6 +92,12 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.CreateFunctionStmt:
 		err = c.createFunction(n)
 
+	case *ast.CreateModelStmt:
+		err = c.createModel(n)
+
+	case *ast.CreateRoleStmt:
+		err = c.createRole(n)
+
 	case *ast.CreateSchemaStmt:
 		err = c.createSchema(n)
 
@@ -106,6 +113,12 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.DropFunctionStmt:
 		err = c.dropFunction(n)
 
+	case *ast.DropModelStmt:
+		err = c.dropModel(n)
+
+	case *ast.DropRoleStmt:
+		err = c.dropRole(n)
+
 	case *ast.DropSchemaStmt:
 		err = c.dropSchema(n)
 
@@ -115,6 +128,12 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.DropTypeStmt:
 		err = c.dropType(n)
 
+	case *ast.GrantRoleStmt:
+		err = c.grantRole(n)
+
+	case *ast.GrantStmt:
+		err = c.grant(n)
+
 	case *ast.RenameColumnStmt:
 		err = c.renameColumn(n)
 
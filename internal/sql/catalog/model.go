@@ -0,0 +1,120 @@
+package catalog
+
+import (
+	"errors"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// Model is a machine learning model registered with CREATE MODEL. Querying it
+// with ML.PREDICT returns the Outputs columns along with the columns of the
+// input relation.
+type Model struct {
+	Rel     *ast.TableName
+	Inputs  []*Column
+	Outputs []*Column
+	Comment string
+}
+
+func (s *Schema) getModel(rel *ast.TableName) (*Model, int, error) {
+	for i := range s.Models {
+		if s.Models[i].Rel.Name == rel.Name {
+			return s.Models[i], i, nil
+		}
+	}
+	return nil, -1, sqlerr.RelationNotFound(rel.Name)
+}
+
+func (c *Catalog) getModel(name *ast.TableName) (*Schema, *Model, error) {
+	ns := name.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return nil, nil, err
+	}
+	model, _, err := schema.getModel(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return schema, model, nil
+}
+
+func (c *Catalog) GetModel(rel *ast.TableName) (Model, error) {
+	_, model, err := c.getModel(rel)
+	if model == nil {
+		return Model{}, err
+	}
+	return *model, err
+}
+
+func modelColumns(defs []*ast.ColumnDef) []*Column {
+	cols := make([]*Column, 0, len(defs))
+	for _, def := range defs {
+		cols = append(cols, &Column{
+			Name:      def.Colname,
+			Type:      *def.TypeName,
+			IsNotNull: def.IsNotNull,
+			IsArray:   def.IsArray,
+			ArrayDims: def.ArrayDims,
+			Comment:   def.Comment,
+		})
+	}
+	return cols
+}
+
+func (c *Catalog) createModel(stmt *ast.CreateModelStmt) error {
+	ns := stmt.Name.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	model := &Model{
+		Rel:     stmt.Name,
+		Inputs:  modelColumns(stmt.Inputs),
+		Outputs: modelColumns(stmt.Outputs),
+	}
+	_, idx, err := schema.getModel(stmt.Name)
+	if err == nil {
+		if stmt.IfNotExists {
+			return nil
+		}
+		if !stmt.Replace {
+			return sqlerr.RelationExists(stmt.Name.Name)
+		}
+		schema.Models[idx] = model
+		return nil
+	}
+	schema.Models = append(schema.Models, model)
+	return nil
+}
+
+func (c *Catalog) dropModel(stmt *ast.DropModelStmt) error {
+	for _, name := range stmt.Models {
+		ns := name.Schema
+		if ns == "" {
+			ns = c.DefaultSchema
+		}
+		schema, err := c.getSchema(ns)
+		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		_, idx, err := schema.getModel(name)
+		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		schema.Models = append(schema.Models[:idx], schema.Models[idx+1:]...)
+	}
+	return nil
+}
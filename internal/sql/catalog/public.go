@@ -33,6 +33,17 @@ func (c *Catalog) ListFuncsByName(rel *ast.FuncName) ([]Function, error) {
 }
 
 func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
+	funs, err := c.ResolveFuncCallOverloads(call)
+	if err != nil {
+		return nil, err
+	}
+	return funs[0], nil
+}
+
+// ResolveFuncCallOverloads returns the functions that accept the arguments of
+// call, in catalog order. ResolveFuncCall picks the first one; engines that
+// know the types of the arguments can pick a better match.
+func (c *Catalog) ResolveFuncCallOverloads(call *ast.FuncCall) ([]*Function, error) {
 	// Do not validate unknown functions
 	funs, err := c.ListFuncsByName(call.Func)
 	if err != nil || len(funs) == 0 {
@@ -63,6 +74,7 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 		}
 	}
 
+	var matches []*Function
 	for _, fun := range funs {
 		args := fun.InArgs()
 		var defaults int
@@ -107,7 +119,10 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 			continue
 		}
 
-		return &fun, nil
+		matches = append(matches, &fun)
+	}
+	if len(matches) > 0 {
+		return matches, nil
 	}
 
 	var sig []string
@@ -0,0 +1,208 @@
+package catalog
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+// Role is a database role created with CREATE ROLE. Privileges holds the
+// grants made to the role and Roles the names of the roles it inherits.
+//
+// Grants are recorded for roles defined in the schema only; roles managed
+// outside of it are ignored.
+type Role struct {
+	Name       string
+	Privileges []*Privilege
+	Roles      []string
+}
+
+// Privilege allows a role to run Name (select, insert, update, delete or
+// execute) against an object. Columns limits the privilege to those columns
+// and is empty when it applies to the whole object.
+type Privilege struct {
+	Name    string
+	Objtype ast.GrantObjectType
+	Rel     *ast.TableName
+	Columns []string
+}
+
+func (p *Privilege) equal(o *Privilege) bool {
+	if p.Name != o.Name || p.Objtype != o.Objtype || *p.Rel != *o.Rel {
+		return false
+	}
+	if len(p.Columns) != len(o.Columns) {
+		return false
+	}
+	for i := range p.Columns {
+		if p.Columns[i] != o.Columns[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Catalog) GetRole(name string) (Role, bool) {
+	role := c.getRole(name)
+	if role == nil {
+		return Role{}, false
+	}
+	return *role, true
+}
+
+func (c *Catalog) getRole(name string) *Role {
+	for _, role := range c.Roles {
+		if role.Name == name {
+			return role
+		}
+	}
+	return nil
+}
+
+// Role statements never fail: they don't change how queries are typed, and
+// the schema may reference roles that are created elsewhere.
+func (c *Catalog) createRole(stmt *ast.CreateRoleStmt) error {
+	if stmt.Role == nil || c.getRole(*stmt.Role) != nil {
+		return nil
+	}
+	c.Roles = append(c.Roles, &Role{Name: *stmt.Role})
+	return nil
+}
+
+func (c *Catalog) dropRole(stmt *ast.DropRoleStmt) error {
+	for _, name := range roleNames(stmt.Roles) {
+		for i, role := range c.Roles {
+			if role.Name == name {
+				c.Roles = append(c.Roles[:i], c.Roles[i+1:]...)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) grant(stmt *ast.GrantStmt) error {
+	var privs []*Privilege
+	for _, obj := range grantObjects(stmt) {
+		if stmt.Privileges == nil {
+			continue
+		}
+		for _, item := range stmt.Privileges.Items {
+			ap, ok := item.(*ast.AccessPriv)
+			if !ok || ap.PrivName == nil {
+				continue
+			}
+			priv := &Privilege{
+				Name:    *ap.PrivName,
+				Objtype: stmt.Objtype,
+				Rel:     obj,
+			}
+			if ap.Cols != nil {
+				for _, col := range ap.Cols.Items {
+					if s, ok := col.(*ast.String); ok {
+						priv.Columns = append(priv.Columns, s.Str)
+					}
+				}
+			}
+			privs = append(privs, priv)
+		}
+	}
+	for _, name := range roleNames(stmt.Grantees) {
+		role := c.getRole(name)
+		if role == nil {
+			continue
+		}
+		for _, priv := range privs {
+			if stmt.IsGrant {
+				role.Privileges = append(role.Privileges, priv)
+				continue
+			}
+			for i := range role.Privileges {
+				if role.Privileges[i].equal(priv) {
+					role.Privileges = append(role.Privileges[:i], role.Privileges[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) grantRole(stmt *ast.GrantRoleStmt) error {
+	var granted []string
+	if stmt.GrantedRoles != nil {
+		for _, item := range stmt.GrantedRoles.Items {
+			if ap, ok := item.(*ast.AccessPriv); ok && ap.PrivName != nil {
+				granted = append(granted, *ap.PrivName)
+			}
+		}
+	}
+	for _, name := range roleNames(stmt.GranteeRoles) {
+		role := c.getRole(name)
+		if role == nil {
+			continue
+		}
+		for _, g := range granted {
+			idx := -1
+			for i := range role.Roles {
+				if role.Roles[i] == g {
+					idx = i
+				}
+			}
+			switch {
+			case stmt.IsGrant && idx < 0:
+				role.Roles = append(role.Roles, g)
+			case !stmt.IsGrant && idx >= 0:
+				role.Roles = append(role.Roles[:idx], role.Roles[idx+1:]...)
+			}
+		}
+	}
+	return nil
+}
+
+func roleNames(list *ast.List) []string {
+	var names []string
+	if list == nil {
+		return names
+	}
+	for _, item := range list.Items {
+		if spec, ok := item.(*ast.RoleSpec); ok && spec.Rolename != nil {
+			names = append(names, *spec.Rolename)
+		}
+	}
+	return names
+}
+
+func grantObjects(stmt *ast.GrantStmt) []*ast.TableName {
+	var objs []*ast.TableName
+	if stmt.Objects == nil {
+		return objs
+	}
+	for _, item := range stmt.Objects.Items {
+		switch n := item.(type) {
+		case *ast.RangeVar:
+			name := &ast.TableName{}
+			if n.Schemaname != nil {
+				name.Schema = *n.Schemaname
+			}
+			if n.Relname != nil {
+				name.Name = *n.Relname
+			}
+			objs = append(objs, name)
+		case *ast.ObjectWithArgs:
+			var parts []string
+			if n.Objname != nil {
+				for _, part := range n.Objname.Items {
+					if s, ok := part.(*ast.String); ok {
+						parts = append(parts, s.Str)
+					}
+				}
+			}
+			switch len(parts) {
+			case 1:
+				objs = append(objs, &ast.TableName{Name: parts[0]})
+			case 2:
+				objs = append(objs, &ast.TableName{Schema: parts[0], Name: parts[1]})
+			}
+		}
+	}
+	return objs
+}
@@ -14,6 +14,7 @@ type Schema struct {
 	Tables []*Table
 	Types  []Type
 	Funcs  []*Function
+	Models []*Model
 
 	Comment string
 }
@@ -66,6 +67,11 @@ func (s *Schema) getTable(rel *ast.TableName) (*Table, int, error) {
 		if s.Tables[i].Rel.Name == rel.Name {
 			return s.Tables[i], i, nil
 		}
+		for _, syn := range s.Tables[i].Synonyms {
+			if syn == rel.Name {
+				return s.Tables[i], i, nil
+			}
+		}
 	}
 	return nil, -1, sqlerr.RelationNotFound(rel.Name)
 }
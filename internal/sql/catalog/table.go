@@ -3,6 +3,7 @@ package catalog
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
@@ -16,6 +17,32 @@ type Table struct {
 	Rel     *ast.TableName
 	Columns []*Column
 	Comment string
+
+	// Alternate names of a Spanner table, resolvable wherever the table name is
+	Synonyms []string
+
+	// The defining query of a view, and whether it runs with the privileges
+	// of the role querying the view rather than those of the view itself
+	Query           ast.Node
+	SecurityInvoker bool
+
+	// Columns of the primary key. Empty when the table has none, or when the
+	// engine doesn't record it.
+	PrimaryKey []string
+
+	// FOREIGN KEY constraints, and the parent of a Spanner table interleaved
+	// in another
+	ForeignKeys []*ForeignKey
+	Parent      *Table
+}
+
+// ForeignKey is a FOREIGN KEY constraint of a table. The referenced table is
+// kept by pointer, so renaming it doesn't break the constraint.
+type ForeignKey struct {
+	Name       string
+	Columns    []string
+	Table      *Table
+	RefColumns []string
 }
 
 func checkMissing(err error, missingOK bool) error {
@@ -71,6 +98,114 @@ func (table *Table) alterColumnType(cmd *ast.AlterTableCmd) error {
 	return nil
 }
 
+func (table *Table) setOptions(cmd *ast.AlterTableCmd) error {
+	index, err := table.isExistColumn(cmd)
+	if err != nil {
+		return err
+	}
+	if index >= 0 {
+		table.Columns[index].AllowCommitTimestamp = cmd.Def.AllowCommitTimestamp
+	}
+	return nil
+}
+
+func (c *Catalog) addSynonym(schema *Schema, table *Table, cmd *ast.AlterTableCmd) error {
+	if _, _, err := schema.getTable(&ast.TableName{Name: *cmd.Name}); err == nil {
+		return sqlerr.RelationExists(*cmd.Name)
+	}
+	table.Synonyms = append(table.Synonyms, *cmd.Name)
+	return nil
+}
+
+func (table *Table) dropSynonym(cmd *ast.AlterTableCmd) error {
+	for i, syn := range table.Synonyms {
+		if syn == *cmd.Name {
+			table.Synonyms = append(table.Synonyms[:i], table.Synonyms[i+1:]...)
+			return nil
+		}
+	}
+	return sqlerr.RelationNotFound(*cmd.Name)
+}
+
+// addForeignKey checks that the columns of a FOREIGN KEY and the table and
+// columns it references exist, and adds it to table.
+func (c *Catalog) addForeignKey(table *Table, cmd *ast.AlterTableCmd) error {
+	con := cmd.Constraint
+	fk := &ForeignKey{}
+	if con.Conname != nil {
+		fk.Name = *con.Conname
+		for _, other := range table.ForeignKeys {
+			if other.Name == fk.Name {
+				return &sqlerr.Error{
+					Err:     sqlerr.Exists,
+					Code:    "42710",
+					Message: fmt.Sprintf("constraint %q for relation %q", fk.Name, table.Rel.Name),
+				}
+			}
+		}
+	}
+	// Report missing tables and columns at the constraint
+	located := func(err error) error {
+		var serr *sqlerr.Error
+		if errors.As(err, &serr) && serr.Location == 0 {
+			serr.Location = con.Location
+		}
+		return err
+	}
+	ref := &ast.TableName{Name: *con.Pktable.Relname}
+	if con.Pktable.Schemaname != nil {
+		ref.Schema = *con.Pktable.Schemaname
+	}
+	_, refTable, err := c.getTable(ref)
+	if err != nil {
+		return located(err)
+	}
+	fk.Table = refTable
+	if fk.Columns, err = table.columnNames(con.FkAttrs); err != nil {
+		return located(err)
+	}
+	if fk.RefColumns, err = refTable.columnNames(con.PkAttrs); err != nil {
+		return located(err)
+	}
+	table.ForeignKeys = append(table.ForeignKeys, fk)
+	return nil
+}
+
+// columnNames returns the names in list, which must be columns of table
+func (table *Table) columnNames(list *ast.List) ([]string, error) {
+	var names []string
+	for _, item := range list.Items {
+		name := item.(*ast.String).Str
+		if _, err := table.isExistColumn(&ast.AlterTableCmd{Name: &name}); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// dropConstraint drops the FOREIGN KEY of the name. Other constraints, such
+// as CHECK constraints, aren't recorded.
+func (table *Table) dropConstraint(cmd *ast.AlterTableCmd) {
+	table.ForeignKeys = slices.DeleteFunc(table.ForeignKeys, func(fk *ForeignKey) bool {
+		return fk.Name == *cmd.Name
+	})
+}
+
+// setInterleaveIn interleaves table in its parent, whose primary key must be
+// a prefix of the primary key of table.
+func (c *Catalog) setInterleaveIn(table *Table, cmd *ast.AlterTableCmd) error {
+	_, parent, err := c.getTable(cmd.Parent)
+	if err != nil {
+		return err
+	}
+	if len(parent.PrimaryKey) > len(table.PrimaryKey) || !slices.Equal(parent.PrimaryKey, table.PrimaryKey[:len(parent.PrimaryKey)]) {
+		return fmt.Errorf("the primary key of %q must start with the primary key of its parent %q", table.Rel.Name, parent.Rel.Name)
+	}
+	table.Parent = parent
+	return nil
+}
+
 func (c *Catalog) dropColumn(table *Table, cmd *ast.AlterTableCmd) error {
 	index, err := table.isExistColumn(cmd)
 	if err != nil {
@@ -91,6 +226,10 @@ func (c *Catalog) dropColumn(table *Table, cmd *ast.AlterTableCmd) error {
 		}
 	}
 	table.Columns = append(table.Columns[:index], table.Columns[index+1:]...)
+	// Dropping a column of the primary key drops the constraint
+	if slices.Contains(table.PrimaryKey, col.Name) {
+		table.PrimaryKey = nil
+	}
 	return nil
 }
 
@@ -129,6 +268,9 @@ type Column struct {
 	Comment    string
 	Length     *int
 
+	// Spanner columns declared with OPTIONS (allow_commit_timestamp = true)
+	AllowCommitTimestamp bool
+
 	linkedType bool
 }
 
@@ -177,6 +319,18 @@ func isStmtImplemented(stmt *ast.AlterTableStmt) bool {
 				implemented = true
 			case ast.AT_SetNotNull:
 				implemented = true
+			case ast.AT_SetOptions:
+				implemented = true
+			case ast.AT_AddSynonym:
+				implemented = true
+			case ast.AT_DropSynonym:
+				implemented = true
+			case ast.AT_AddConstraint:
+				implemented = true
+			case ast.AT_DropConstraint:
+				implemented = true
+			case ast.AT_SetInterleaveIn:
+				implemented = true
 			}
 		}
 	}
@@ -187,7 +341,7 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 	if !isStmtImplemented(stmt) {
 		return nil
 	}
-	_, table, err := c.getTable(stmt.Table)
+	schema, table, err := c.getTable(stmt.Table)
 	if err != nil {
 		return checkMissing(err, stmt.MissingOk)
 	}
@@ -215,6 +369,28 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 				if err := table.setNotNull(cmd); err != nil {
 					return err
 				}
+			case ast.AT_SetOptions:
+				if err := table.setOptions(cmd); err != nil {
+					return err
+				}
+			case ast.AT_AddSynonym:
+				if err := c.addSynonym(schema, table, cmd); err != nil {
+					return err
+				}
+			case ast.AT_DropSynonym:
+				if err := table.dropSynonym(cmd); err != nil {
+					return err
+				}
+			case ast.AT_AddConstraint:
+				if err := c.addForeignKey(table, cmd); err != nil {
+					return err
+				}
+			case ast.AT_DropConstraint:
+				table.dropConstraint(cmd)
+			case ast.AT_SetInterleaveIn:
+				if err := c.setInterleaveIn(table, cmd); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -263,7 +439,7 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 		return sqlerr.RelationExists(stmt.Name.Name)
 	}
 
-	tbl := Table{Rel: stmt.Name, Comment: stmt.Comment}
+	tbl := Table{Rel: stmt.Name, Comment: stmt.Comment, PrimaryKey: stmt.PrimaryKey}
 	coltype := make(map[string]ast.TypeName) // used to check for duplicate column names
 	seen := make(map[string]bool)            // used to check for duplicate column names
 	for _, inheritTable := range stmt.Inherits {
@@ -338,6 +514,8 @@ func (c *Catalog) defineColumn(table *ast.TableName, col *ast.ColumnDef) (*Colum
 		ArrayDims:  col.ArrayDims,
 		Comment:    col.Comment,
 		Length:     col.Length,
+
+		AllowCommitTimestamp: col.AllowCommitTimestamp,
 	}
 	if col.Vals != nil {
 		typeName := ast.TypeName{
@@ -406,6 +584,11 @@ func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
 	if idx == -1 {
 		return sqlerr.ColumnNotFound(tbl.Rel.Name, stmt.Col.Name)
 	}
+	for i := range tbl.PrimaryKey {
+		if tbl.PrimaryKey[i] == stmt.Col.Name {
+			tbl.PrimaryKey[i] = *stmt.NewName
+		}
+	}
 	tbl.Columns[idx].Name = *stmt.NewName
 
 	if tbl.Columns[idx].linkedType {
@@ -26,7 +26,9 @@ func (c *Catalog) createView(stmt *ast.ViewStmt, colGen columnGenerator) error {
 			Schema:  schemaName,
 			Name:    *stmt.View.Relname,
 		},
-		Columns: cols,
+		Columns:         cols,
+		Query:           stmt.Query,
+		SecurityInvoker: stmt.SecurityInvoker,
 	}
 
 	ns := tbl.Rel.Schema
@@ -42,9 +42,10 @@ func (n nullability) String() string {
 // - named parameter operator        @param
 // - named parameter function calls  sqlc.arg(param)
 type Param struct {
-	name        string
-	nullability nullability
-	isSqlcSlice bool
+	name           string
+	nullability    nullability
+	isSqlcSlice    bool
+	isSqlcOptional bool
 }
 
 // NewParam builds a new params with unspecified nullability
@@ -72,6 +73,11 @@ func NewSqlcSlice(name string) Param {
 	return Param{name: name, nullability: nullUnspecified, isSqlcSlice: true}
 }
 
+// NewSqlcOptional is a sqlc.optional() parameter.
+func NewSqlcOptional(name string) Param {
+	return Param{name: name, nullability: nullUnspecified, isSqlcOptional: true}
+}
+
 // Name is the user defined name to use for this parameter
 func (p Param) Name() string {
 	return p.name
@@ -113,6 +119,11 @@ func (p Param) IsSqlcSlice() bool {
 	return p.isSqlcSlice
 }
 
+// IsSqlcOptional returns whether this param is a sqlc.optional() param.
+func (p Param) IsSqlcOptional() bool {
+	return p.isSqlcOptional
+}
+
 // mergeParam creates a new param from 2 partially specified params
 // If the parameters have different names, the first is preferred
 func mergeParam(a, b Param) Param {
@@ -122,8 +133,9 @@ func mergeParam(a, b Param) Param {
 	}
 
 	return Param{
-		name:        name,
-		nullability: a.nullability | b.nullability,
-		isSqlcSlice: a.isSqlcSlice || b.isSqlcSlice,
+		name:           name,
+		nullability:    a.nullability | b.nullability,
+		isSqlcSlice:    a.isSqlcSlice || b.isSqlcSlice,
+		isSqlcOptional: a.isSqlcOptional || b.isSqlcOptional,
 	}
 }
@@ -0,0 +1,193 @@
+package rewrite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/source"
+)
+
+// Keyset is the ORDER BY clause of a query paginated with
+// @paginate keyset(...)
+type Keyset struct {
+	// The sort expressions, as written in the query
+	Exprs      []string
+	Descending bool
+}
+
+// Clauses that can't be combined with keyset pagination, as they change what
+// a page of rows is
+var keysetConflicts = map[string]string{
+	"EXCEPT": "EXCEPT", "FETCH": "FETCH", "GROUP": "GROUP BY",
+	"HAVING": "HAVING", "INTERSECT": "INTERSECT", "LIMIT": "LIMIT",
+	"OFFSET": "OFFSET", "UNION": "UNION",
+}
+
+// KeysetPagination checks that the ORDER BY clause of a query sorts by the
+// keyset columns, and marks where the generated code adds the predicate that
+// skips the rows before the cursor, and the LIMIT:
+//
+//	/*KEYSET:WHERE*/ or /*KEYSET:AND*/ at the end of the WHERE clause
+//	/*LIMIT*/ at the end of the ORDER BY clause
+//
+// The condition of an existing WHERE clause is wrapped in parentheses, so that
+// the predicate can be joined to it with AND.
+func KeysetPagination(query string, columns []string) (*Keyset, []source.Edit, error) {
+	tokens := tokenize(query)
+
+	depth := 0
+	where, orderBy := -1, -1
+	// The WINDOW or QUALIFY clause, or the ORDER BY clause, that a WHERE
+	// clause comes before
+	tail := -1
+	for i, t := range tokens {
+		switch t.text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+		if depth > 0 {
+			continue
+		}
+		if clause, ok := keysetConflicts[t.text]; ok {
+			return nil, nil, fmt.Errorf("can't be used with %s", clause)
+		}
+		switch t.text {
+		case "WHERE":
+			where = i
+		case "WINDOW", "QUALIFY":
+			if tail < 0 {
+				tail = i
+			}
+		case "ORDER":
+			if i+1 < len(tokens) && tokens[i+1].text == "BY" {
+				if orderBy >= 0 {
+					return nil, nil, fmt.Errorf("requires a single ORDER BY clause")
+				}
+				orderBy = i
+				if tail < 0 {
+					tail = i
+				}
+			}
+		}
+	}
+	if orderBy < 0 {
+		return nil, nil, fmt.Errorf("requires an ORDER BY clause")
+	}
+
+	keyset, last, err := sortColumns(query, tokens, orderBy+2, columns)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var edits []source.Edit
+	if where >= 0 {
+		end := where + 1
+		for depth := 0; end < tail; end++ {
+			t := tokens[end].text
+			if t == "(" {
+				depth++
+			} else if t == ")" {
+				depth--
+			} else if depth == 0 && predicateEnd[t] {
+				break
+			}
+		}
+		if end == where+1 {
+			return nil, nil, fmt.Errorf("requires a condition in the WHERE clause")
+		}
+		edits = append(edits,
+			source.Edit{Location: tokens[where+1].start, New: "("},
+			source.Edit{Location: tokens[end-1].end, New: ")/*KEYSET:AND*/"},
+		)
+	} else {
+		edits = append(edits, source.Edit{Location: tokens[tail-1].end, New: "/*KEYSET:WHERE*/"})
+	}
+	edits = append(edits, source.Edit{Location: tokens[last].end, New: "/*LIMIT*/"})
+	return keyset, edits, nil
+}
+
+// sortColumns reads the items of the ORDER BY clause that starts at tokens[i],
+// which must sort by columns in the same direction. It returns the index of
+// the last token of the clause.
+func sortColumns(query string, tokens []token, i int, columns []string) (*Keyset, int, error) {
+	keyset := &Keyset{}
+	var names []string
+	last := i - 1
+	for n := 0; i < len(tokens); n++ {
+		if predicateEnd[tokens[i].text] {
+			break
+		}
+		start := i
+		// A column is written as [qualifier.]name
+		for i < len(tokens) && isIdentifier(query, tokens[i]) {
+			last = i
+			if i+1 < len(tokens) && tokens[i+1].text == "." {
+				i += 2
+				continue
+			}
+			i++
+			break
+		}
+		if last < start {
+			return nil, 0, fmt.Errorf("ORDER BY item %d must be a column", n+1)
+		}
+		expr := query[tokens[start].start:tokens[last].end]
+		names = append(names, unquote(query[tokens[last].start:tokens[last].end]))
+
+		descending := false
+		if i < len(tokens) && (tokens[i].text == "ASC" || tokens[i].text == "DESC") {
+			descending = tokens[i].text == "DESC"
+			last = i
+			i++
+		}
+		if n == 0 {
+			keyset.Descending = descending
+		} else if descending != keyset.Descending {
+			return nil, 0, fmt.Errorf("requires every ORDER BY item to sort in the same direction")
+		}
+		keyset.Exprs = append(keyset.Exprs, expr)
+
+		if i == len(tokens) || predicateEnd[tokens[i].text] {
+			break
+		}
+		if tokens[i].text != "," {
+			return nil, 0, fmt.Errorf("ORDER BY item %q must be a column sorted with ASC or DESC", query[tokens[start].start:tokens[i].end])
+		}
+		i++
+	}
+
+	if len(names) != len(columns) {
+		return nil, 0, keysetMismatch(columns)
+	}
+	for i := range names {
+		if !strings.EqualFold(names[i], columns[i]) {
+			return nil, 0, keysetMismatch(columns)
+		}
+	}
+	return keyset, last, nil
+}
+
+func keysetMismatch(columns []string) error {
+	return fmt.Errorf("requires the query to be ORDER BY %s", strings.Join(columns, ", "))
+}
+
+// isIdentifier reports whether t is a name, either bare or quoted
+func isIdentifier(query string, t token) bool {
+	switch t.text {
+	case "":
+		return query[t.start] == '"' || query[t.start] == '`'
+	case "ASC", "DESC", "NULLS", "COLLATE":
+		return false
+	}
+	c := t.text[0]
+	return c == '_' || ('A' <= c && c <= 'Z') || c >= 0x80
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '`') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,269 @@
+package rewrite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/source"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/named"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+func isOptionalFunc(call *ast.FuncCall) bool {
+	return call.Func != nil && call.Func.Schema == "sqlc" && call.Func.Name == "optional"
+}
+
+// OptionalPredicates marks the predicates that use a sqlc.optional()
+// parameter. Each one is wrapped in /*OPTIONAL:name*/ and /*END*/ comments so
+// that the generated code can replace it with TRUE when the parameter is nil.
+//
+// An optional predicate is a condition of a WHERE or HAVING clause joined to
+// the rest of the clause with AND. Its bounds are found in the query text, as
+// the engines don't record where an expression ends.
+func OptionalPredicates(raw *ast.RawStmt, query string) ([]source.Edit, error) {
+	calls := astutils.Search(raw, func(node ast.Node) bool {
+		call, ok := node.(*ast.FuncCall)
+		return ok && isOptionalFunc(call)
+	})
+	if len(calls.Items) == 0 {
+		return nil, nil
+	}
+
+	params := astutils.Search(raw, func(node ast.Node) bool {
+		_, ok := node.(*ast.ParamRef)
+		return ok || named.IsParamFunc(node) || named.IsParamSign(node)
+	})
+	uses := map[string]int{}
+	for _, node := range params.Items {
+		switch n := node.(type) {
+		case *ast.FuncCall:
+			name, _ := flatten(n.Args)
+			uses[name]++
+		case *ast.A_Expr:
+			name, _ := flatten(n.Rexpr)
+			uses[name]++
+		}
+	}
+
+	tokens := tokenize(query)
+	var edits []source.Edit
+	for _, item := range calls.Items {
+		call := item.(*ast.FuncCall)
+		param, origText := paramFromFuncCall(call)
+		name := param.Name()
+		if uses[name] > 1 {
+			return nil, optionalErrorf(call, "sqlc.optional(%s) can only be used once", name)
+		}
+
+		callStart := call.Location - raw.StmtLocation
+		callEnd := callStart + len(origText)
+		start, end, err := predicateBounds(tokens, callStart, callEnd)
+		if err != nil {
+			return nil, optionalErrorf(call, "sqlc.optional(%s) %s", name, err)
+		}
+		for _, node := range params.Items {
+			loc := node.Pos() - raw.StmtLocation
+			if node != call && loc >= start && loc < end {
+				return nil, optionalErrorf(call, "the predicate using sqlc.optional(%s) can't use other parameters", name)
+			}
+		}
+
+		// This sequence is also replicated in internal/codegen/golang.Query
+		// since it's needed during template generation for replacement
+		edits = append(edits,
+			source.Edit{Location: start, New: fmt.Sprintf("/*OPTIONAL:%s*/", name)},
+			source.Edit{Location: end, New: "/*END*/"},
+		)
+	}
+	return edits, nil
+}
+
+func optionalErrorf(call *ast.FuncCall, format string, args ...any) *sqlerr.Error {
+	return &sqlerr.Error{
+		Message:  fmt.Sprintf(format, args...),
+		Location: call.Pos(),
+	}
+}
+
+type token struct {
+	start, end int
+	// Keywords and identifiers are upper-cased, literals are empty
+	text string
+}
+
+// tokenize splits a statement into the tokens needed to find the bounds of a
+// predicate. Whitespace and comments are skipped.
+func tokenize(s string) []token {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.HasPrefix(s[i:], "--"):
+			n := strings.IndexByte(s[i:], '\n')
+			if n < 0 {
+				n = len(s) - i
+			}
+			i += n
+		case strings.HasPrefix(s[i:], "/*"):
+			n := strings.Index(s[i+2:], "*/")
+			if n < 0 {
+				n = len(s) - i - 4
+			}
+			i += n + 4
+		case c == '\'' || c == '"' || c == '`':
+			j := i + 1
+			for j < len(s) && s[j] != c {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			j = min(j+1, len(s))
+			tokens = append(tokens, token{start: i, end: j})
+			i = j
+		case isWordByte(c):
+			j := i
+			for j < len(s) && isWordByte(s[j]) {
+				j++
+			}
+			tokens = append(tokens, token{start: i, end: j, text: strings.ToUpper(s[i:j])})
+			i = j
+		default:
+			tokens = append(tokens, token{start: i, end: i + 1, text: s[i : i+1]})
+			i++
+		}
+	}
+	return tokens
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '$' || c == '@' || c >= 0x80 ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// Keywords that end a predicate of a WHERE or HAVING clause
+var predicateEnd = map[string]bool{
+	";": true, "EXCEPT": true, "FETCH": true, "FOR": true, "GROUP": true,
+	"HAVING": true, "INTERSECT": true, "LIMIT": true, "OFFSET": true,
+	"ORDER": true, "QUALIFY": true, "RETURNING": true, "UNION": true,
+	"WINDOW": true,
+}
+
+// predicateBounds returns the offsets of the predicate that contains the
+// sqlc.optional() call between callStart and callEnd.
+func predicateBounds(tokens []token, callStart, callEnd int) (int, int, error) {
+	call := -1
+	for i, t := range tokens {
+		if t.start == callStart {
+			call = i
+			break
+		}
+	}
+	if call < 0 {
+		return 0, 0, fmt.Errorf("could not be found in the query")
+	}
+
+	// Walk back to the AND, WHERE or HAVING that comes before the predicate.
+	// Parentheses that aren't grouping conditions, as in NOT (...) or
+	// lower(...), are part of the predicate. A comma or a CASE branch is only
+	// allowed within them.
+	errNotCondition := fmt.Errorf("must be used in a condition of a WHERE or HAVING clause")
+	first := call
+	depth := 0
+	unenclosed := false
+backward:
+	for i := call - 1; i >= 0; i-- {
+		switch t := tokens[i].text; {
+		case t == ")" || t == "END":
+			depth++
+		case (t == "(" || t == "CASE") && depth > 0:
+			depth--
+		case depth > 0:
+		case t == "CASE":
+			unenclosed = false
+		case t == "(":
+			if i == 0 {
+				break backward
+			}
+			switch tokens[i-1].text {
+			case "WHERE", "HAVING", "AND", "(":
+				break backward
+			case "OR":
+				return 0, 0, fmt.Errorf("can't be used in an OR condition")
+			}
+			unenclosed = false
+		case t == "WHERE" || t == "HAVING":
+			break backward
+		case t == "AND":
+			if !inBetween(tokens[:i]) {
+				break backward
+			}
+		case t == "OR":
+			return 0, 0, fmt.Errorf("can't be used in an OR condition")
+		case t == "WHEN" || t == "THEN" || t == "ELSE" || t == ",":
+			unenclosed = true
+		case t == "ON" || t == "SELECT" || t == "SET" || t == ";":
+			return 0, 0, errNotCondition
+		}
+		first = i
+	}
+	if first == 0 || unenclosed {
+		return 0, 0, errNotCondition
+	}
+
+	// Walk forward to the end of the predicate
+	last := call
+	depth = 0
+	between := 0
+	for i := first; i < len(tokens); i++ {
+		t := tokens[i].text
+		after := tokens[i].start >= callEnd
+		switch {
+		case t == "(" || t == "CASE":
+			depth++
+		case t == ")" || t == "END":
+			if depth == 0 {
+				return tokens[first].start, tokens[last].end, nil
+			}
+			depth--
+		case depth > 0:
+		case t == "BETWEEN":
+			between++
+		case t == "AND" && between > 0:
+			between--
+		case after && t == "OR":
+			return 0, 0, fmt.Errorf("can't be used in an OR condition")
+		case after && (t == "AND" || predicateEnd[t]):
+			return tokens[first].start, tokens[last].end, nil
+		}
+		last = i
+	}
+	return tokens[first].start, tokens[last].end, nil
+}
+
+// inBetween reports whether an AND at the end of tokens is the one of a
+// BETWEEN ... AND ... condition.
+func inBetween(tokens []token) bool {
+	depth := 0
+	for i := len(tokens) - 1; i >= 0; i-- {
+		switch t := tokens[i].text; {
+		case t == ")" || t == "END":
+			depth++
+		case t == "(" || t == "CASE":
+			if depth == 0 {
+				return false
+			}
+			depth--
+		case depth > 0:
+		case t == "BETWEEN":
+			return true
+		case t == "AND" || t == "OR" || t == "WHERE" || t == "HAVING":
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+package rewrite
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/source"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// OrderBy is a sqlc.orderby() call. Its parameter chooses one of Keys at run
+// time.
+type OrderBy struct {
+	Name     string
+	Keys     []string
+	Location int
+}
+
+// A sort key is an output column, optionally followed by a direction
+var sortKey = regexp.MustCompile(`(?i)^([a-z_][a-z0-9_$]*)(\s+(ASC|DESC))?$`)
+
+// Column returns the output column of a sort key
+func (o OrderBy) Column(key string) string {
+	return sortKey.FindStringSubmatch(key)[1]
+}
+
+func isOrderByFunc(call *ast.FuncCall) bool {
+	return call.Func != nil && call.Func.Schema == "sqlc" && call.Func.Name == "orderby"
+}
+
+// OrderBys replaces each sqlc.orderby() call with its first sort key. The key
+// is preceded by an /*ORDERBY:name*/ comment, so that the generated code can
+// replace it with the one chosen at run time.
+func OrderBys(raw *ast.RawStmt, query string) ([]OrderBy, []source.Edit, error) {
+	calls := astutils.Search(raw, func(node ast.Node) bool {
+		call, ok := node.(*ast.FuncCall)
+		return ok && isOrderByFunc(call)
+	})
+	if len(calls.Items) == 0 {
+		return nil, nil, nil
+	}
+
+	tokens := tokenize(query)
+	seen := map[string]bool{}
+	var orderBys []OrderBy
+	var edits []source.Edit
+	for _, item := range calls.Items {
+		call := item.(*ast.FuncCall)
+		name, _ := flatten(call.Args.Items[0])
+		if seen[name] {
+			return nil, nil, orderByErrorf(call, "sqlc.orderby(%s) can only be used once", name)
+		}
+		seen[name] = true
+
+		var keys []string
+		unique := map[string]bool{}
+		for _, arg := range call.Args.Items[1:] {
+			key, _ := flatten(arg)
+			key = strings.Join(strings.Fields(key), " ")
+			if !sortKey.MatchString(key) {
+				return nil, nil, orderByErrorf(call, "sqlc.orderby(%s) has an invalid sort key %q; expected a column, optionally followed by ASC or DESC", name, key)
+			}
+			if unique[strings.ToLower(key)] {
+				return nil, nil, orderByErrorf(call, "sqlc.orderby(%s) lists the sort key %q more than once", name, key)
+			}
+			unique[strings.ToLower(key)] = true
+			keys = append(keys, key)
+		}
+
+		start := call.Location - raw.StmtLocation
+		end, err := orderByEnd(tokens, start)
+		if err != nil {
+			return nil, nil, orderByErrorf(call, "sqlc.orderby(%s) %s", name, err)
+		}
+
+		// This sequence is also replicated in internal/codegen/golang.Query
+		// since it's needed during template generation for replacement
+		edits = append(edits, source.Edit{
+			Location: start,
+			Old:      query[start:end],
+			New:      fmt.Sprintf("/*ORDERBY:%s*/%s", name, keys[0]),
+		})
+		orderBys = append(orderBys, OrderBy{
+			Name:     name,
+			Keys:     keys,
+			Location: call.Pos(),
+		})
+	}
+	return orderBys, edits, nil
+}
+
+func orderByErrorf(call *ast.FuncCall, format string, args ...any) *sqlerr.Error {
+	return &sqlerr.Error{
+		Message:  fmt.Sprintf(format, args...),
+		Location: call.Pos(),
+	}
+}
+
+// orderByEnd returns the offset where the sqlc.orderby() call that starts at
+// callStart ends. The call must be a whole item of an ORDER BY clause.
+func orderByEnd(tokens []token, callStart int) (int, error) {
+	errNotItem := fmt.Errorf("must be an item of an ORDER BY clause")
+	call := -1
+	for i, t := range tokens {
+		if t.start == callStart {
+			call = i
+			break
+		}
+	}
+	if call < 0 {
+		return 0, fmt.Errorf("could not be found in the query")
+	}
+
+	// Walk back to the ORDER BY, over the items that come before the call
+	depth := 0
+	if call == 0 || (tokens[call-1].text != "BY" && tokens[call-1].text != ",") {
+		return 0, errNotItem
+	}
+backward:
+	for i := call - 1; i >= 0; i-- {
+		switch t := tokens[i].text; {
+		case t == ")":
+			depth++
+		case t == "(" && depth > 0:
+			depth--
+		case depth > 0:
+		case t == "BY":
+			if i == 0 || tokens[i-1].text != "ORDER" {
+				return 0, errNotItem
+			}
+			break backward
+		case t == "(" || t == ";" || t == "SELECT" || t == "FROM" || t == "WHERE" ||
+			t == "HAVING" || t == "SET" || t == "VALUES" || t == "RETURNING":
+			return 0, errNotItem
+		}
+		if i == 0 {
+			return 0, errNotItem
+		}
+	}
+
+	// Find the closing parenthesis of the call, which must end the item
+	depth = 0
+	for i := call; i < len(tokens); i++ {
+		switch tokens[i].text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth > 0 {
+				continue
+			}
+			if i+1 < len(tokens) {
+				next := tokens[i+1].text
+				if next != "," && next != ")" && !predicateEnd[next] {
+					return 0, errNotItem
+				}
+			}
+			return tokens[i].end, nil
+		}
+	}
+	return 0, errNotItem
+}
@@ -61,6 +61,8 @@ func paramFromFuncCall(call *ast.FuncCall) (named.Param, string) {
 		param = named.NewUserNullableParam(paramName)
 	case "slice":
 		param = named.NewSqlcSlice(paramName)
+	case "optional":
+		param = named.NewSqlcOptional(paramName)
 	default:
 		param = named.NewParam(paramName)
 	}
@@ -89,11 +91,17 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt, numbs map[int]bool,
 	}
 
 	var edits []source.Edit
-	node := astutils.Apply(raw, func(cr *astutils.Cursor) bool {
+	// sqlc.optional() parameters are numbered after all the others, so that
+	// dropping their predicates never renumbers a required parameter
+	var optionals bool
+	rewriteParams := func(cr *astutils.Cursor) bool {
 		node := cr.Node()
 		switch {
 		case named.IsParamFunc(node):
 			fun := node.(*ast.FuncCall)
+			if isOptionalFunc(fun) != optionals {
+				return false
+			}
 			param, origText := paramFromFuncCall(fun)
 			argn := allParams.Add(param)
 			cr.Replace(&ast.ParamRef{
@@ -102,7 +110,23 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt, numbs map[int]bool,
 			})
 
 			var replace string
-			if engine == config.EngineMySQL || engine == config.EngineSQLite || !dollar {
+			if engine == config.EngineSpanner {
+				replace = fmt.Sprintf("@%s", param.Name())
+				if in, ok := cr.Parent().(*ast.In); ok && param.IsSqlcSlice() {
+					// Spanner binds the slice as a single ARRAY parameter, so
+					// IN (sqlc.slice('ids')) becomes IN UNNEST(@ids). The edit
+					// starts at the IN condition and ends at its closing paren.
+					offset := fun.Location - in.Location + len(origText)
+					edits = append(edits, source.Edit{
+						Location: in.Location - raw.StmtLocation,
+						New:      fmt.Sprintf("UNNEST(@%s)", param.Name()),
+						OldFunc: func(s string) int {
+							return offset + strings.Index(s[offset:], ")") + 1
+						},
+					})
+					return false
+				}
+			} else if engine == config.EngineMySQL || engine == config.EngineSQLite || !dollar {
 				if param.IsSqlcSlice() {
 					// This sequence is also replicated in internal/codegen/golang.Field
 					// since it's needed during template generation for replacement
@@ -158,7 +182,15 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt, numbs map[int]bool,
 		case named.IsParamSign(node):
 			expr := node.(*ast.A_Expr)
 			paramName, _ := flatten(expr.Rexpr)
+			if ref, ok := expr.Rexpr.(*ast.ColumnRef); ok && engine == config.EngineSpanner {
+				// @filter.name is a field of the STRUCT parameter @filter
+				paramName = astutils.Join(ref.Fields, ".")
+			}
 			param := named.NewParam(paramName)
+			if _, ok := cr.Parent().(*ast.In); ok && engine == config.EngineSpanner {
+				// @ids in IN UNNEST(@ids) is an array
+				param = named.NewSqlcSlice(paramName)
+			}
 
 			argn := allParams.Add(param)
 			cr.Replace(&ast.ParamRef{
@@ -189,7 +221,10 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt, numbs map[int]bool,
 		default:
 			return true
 		}
-	}, nil)
+	}
+	node := astutils.Apply(raw, rewriteParams, nil)
+	optionals = true
+	node = astutils.Apply(node, rewriteParams, nil)
 
 	return node.(*ast.RawStmt), allParams, edits
 }
@@ -62,7 +62,28 @@ func validateBatch(n ast.Node) error {
 	return nil
 }
 
+// validateDynamic rejects sqlc.optional() and sqlc.orderby() in commands that
+// run a fixed statement, as the generated code rewrites their SQL at run time.
+func validateDynamic(n ast.Node, cmd string) error {
+	calls := astutils.Search(n, func(node ast.Node) bool {
+		call, ok := node.(*ast.FuncCall)
+		return ok && call.Func != nil && call.Func.Schema == "sqlc" &&
+			(call.Func.Name == "optional" || call.Func.Name == "orderby")
+	})
+	if len(calls.Items) > 0 {
+		call := calls.Items[0].(*ast.FuncCall)
+		return fmt.Errorf("%s doesn't support sqlc.%s()", cmd, call.Func.Name)
+	}
+	return nil
+}
+
 func Cmd(n ast.Node, name, cmd string) error {
+	switch cmd {
+	case metadata.CmdCopyFrom, metadata.CmdBatchExec, metadata.CmdBatchMany, metadata.CmdBatchOne, metadata.CmdPartitioned:
+		if err := validateDynamic(n, cmd); err != nil {
+			return err
+		}
+	}
 	if cmd == metadata.CmdCopyFrom {
 		return validateCopyfrom(n)
 	}
@@ -71,7 +92,7 @@ func Cmd(n ast.Node, name, cmd string) error {
 			return err
 		}
 	}
-	if !(cmd == metadata.CmdMany || cmd == metadata.CmdOne || cmd == metadata.CmdBatchMany || cmd == metadata.CmdBatchOne) {
+	if !(cmd == metadata.CmdMany || cmd == metadata.CmdIter || cmd == metadata.CmdOne || cmd == metadata.CmdBatchMany || cmd == metadata.CmdBatchOne) {
 		return nil
 	}
 	var list *ast.List
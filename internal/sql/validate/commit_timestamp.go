@@ -0,0 +1,209 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// Spanner only accepts PENDING_COMMIT_TIMESTAMP() as the value of an INSERT
+// or UPDATE of a column declared with allow_commit_timestamp = true. It can't
+// be read, compared or passed to another function.
+//
+// https://cloud.google.com/spanner/docs/commit-timestamp
+type commitTimestampVisitor struct {
+	allowed map[*ast.FuncCall]struct{}
+	err     error
+}
+
+func (v *commitTimestampVisitor) Visit(node ast.Node) astutils.Visitor {
+	if v.err != nil {
+		return nil
+	}
+	call, ok := node.(*ast.FuncCall)
+	if !ok || !isPendingCommitTimestamp(call) {
+		return v
+	}
+	if _, ok := v.allowed[call]; !ok {
+		v.err = &sqlerr.Error{
+			Message:  "PENDING_COMMIT_TIMESTAMP() may only be used as a value for INSERT or UPDATE of a commit timestamp column",
+			Location: call.Pos(),
+		}
+		return nil
+	}
+	return v
+}
+
+func isPendingCommitTimestamp(call *ast.FuncCall) bool {
+	if call.Func == nil {
+		return false
+	}
+	if call.Func.Schema != "" && call.Func.Schema != "spanner" {
+		return false
+	}
+	return strings.EqualFold(call.Func.Name, "pending_commit_timestamp")
+}
+
+func CommitTimestamp(c *catalog.Catalog, n ast.Node) error {
+	v := commitTimestampVisitor{allowed: map[*ast.FuncCall]struct{}{}}
+	// The columns written with PENDING_COMMIT_TIMESTAMP(), and their table
+	written := map[string]*ast.RangeVar{}
+
+	// target records a write of val to the named column of rel
+	target := func(rel *ast.RangeVar, name *string, val ast.Node) error {
+		call, ok := val.(*ast.FuncCall)
+		if !ok || !isPendingCommitTimestamp(call) || rel == nil || name == nil {
+			return nil
+		}
+		v.allowed[call] = struct{}{}
+		written[*name] = rel
+		table, err := c.GetTable(rangeVarTableName(rel))
+		if err != nil {
+			// Unknown tables are reported when the query is resolved
+			return nil
+		}
+		for _, col := range table.Columns {
+			if col.Name != *name {
+				continue
+			}
+			if !col.AllowCommitTimestamp {
+				return &sqlerr.Error{
+					Message:  fmt.Sprintf("column %q does not allow commit timestamps; declare it with OPTIONS (allow_commit_timestamp = true)", *name),
+					Location: call.Pos(),
+				}
+			}
+		}
+		return nil
+	}
+
+	switch stmt := n.(type) {
+	case *ast.InsertStmt:
+		sel, ok := stmt.SelectStmt.(*ast.SelectStmt)
+		if ok && sel.ValuesLists != nil && stmt.Cols != nil {
+			for _, item := range sel.ValuesLists.Items {
+				values, ok := item.(*ast.List)
+				if !ok {
+					continue
+				}
+				for i, val := range values.Items {
+					if i >= len(stmt.Cols.Items) {
+						break
+					}
+					res, ok := stmt.Cols.Items[i].(*ast.ResTarget)
+					if !ok {
+						continue
+					}
+					if err := target(stmt.Relation, res.Name, val); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	case *ast.UpdateStmt:
+		if stmt.Relations != nil && len(stmt.Relations.Items) == 1 && stmt.TargetList != nil {
+			rel, _ := stmt.Relations.Items[0].(*ast.RangeVar)
+			for _, item := range stmt.TargetList.Items {
+				res, ok := item.(*ast.ResTarget)
+				if !ok {
+					continue
+				}
+				if err := target(rel, res.Name, res.Val); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	astutils.Walk(&v, n)
+	if v.err != nil {
+		return v.err
+	}
+	if len(written) == 0 {
+		return nil
+	}
+	// The WHERE clause reads the rows as they were before the statement, so
+	// only the values written and returned can read the commit timestamp
+	var read []*ast.List
+	switch stmt := n.(type) {
+	case *ast.InsertStmt:
+		read = append(read, stmt.ReturningList)
+	case *ast.UpdateStmt:
+		read = append(read, stmt.TargetList, stmt.ReturningList)
+	}
+	r := pendingReadVisitor{written: written}
+	for _, list := range read {
+		if list != nil {
+			astutils.Walk(&r, list)
+		}
+	}
+	return r.err
+}
+
+// pendingReadVisitor finds a read of a column that the statement writes with
+// PENDING_COMMIT_TIMESTAMP(), in another SET value or THEN RETURN. Spanner
+// doesn't let a transaction read a commit timestamp before it commits.
+// Subqueries have their own tables, and aren't searched.
+type pendingReadVisitor struct {
+	written map[string]*ast.RangeVar
+	err     error
+}
+
+func (v *pendingReadVisitor) Visit(node ast.Node) astutils.Visitor {
+	if v.err != nil {
+		return nil
+	}
+	switch n := node.(type) {
+	case *ast.SubLink, *ast.RangeSubselect:
+		return nil
+	case *ast.ColumnRef:
+		if n.Fields == nil || len(n.Fields.Items) == 0 {
+			return nil
+		}
+		fields := n.Fields.Items
+		name, ok := fields[len(fields)-1].(*ast.String)
+		if !ok {
+			return nil
+		}
+		rel, ok := v.written[name.Str]
+		if !ok {
+			return nil
+		}
+		if len(fields) > 1 {
+			qualifier, ok := fields[len(fields)-2].(*ast.String)
+			if !ok || !refersTo(rel, qualifier.Str) {
+				return nil
+			}
+		}
+		v.err = &sqlerr.Error{
+			Message:  fmt.Sprintf("column %q is written with PENDING_COMMIT_TIMESTAMP() and can't be read in the same transaction", name.Str),
+			Location: n.Pos(),
+		}
+		return nil
+	}
+	return v
+}
+
+func refersTo(rel *ast.RangeVar, qualifier string) bool {
+	if rel.Alias != nil && rel.Alias.Aliasname != nil {
+		return *rel.Alias.Aliasname == qualifier
+	}
+	return rel.Relname != nil && *rel.Relname == qualifier
+}
+
+func rangeVarTableName(rv *ast.RangeVar) *ast.TableName {
+	name := &ast.TableName{}
+	if rv.Catalogname != nil {
+		name.Catalog = *rv.Catalogname
+	}
+	if rv.Schemaname != nil {
+		name.Schema = *rv.Schemaname
+	}
+	if rv.Relname != nil {
+		name.Name = *rv.Relname
+	}
+	return name
+}
@@ -26,14 +26,20 @@ func (v *sqlcFuncVisitor) Visit(node ast.Node) astutils.Visitor {
 		return v
 	}
 
-	// Custom validation for sqlc.arg, sqlc.narg and sqlc.slice
+	// Custom validation for sqlc.arg, sqlc.narg, sqlc.slice, sqlc.optional
+	// and sqlc.orderby
 	// TODO: Replace this once type-checking is implemented
 	if fn.Schema == "sqlc" {
-		if !(fn.Name == "arg" || fn.Name == "narg" || fn.Name == "slice" || fn.Name == "embed") {
+		if !(fn.Name == "arg" || fn.Name == "narg" || fn.Name == "slice" || fn.Name == "optional" || fn.Name == "orderby" || fn.Name == "embed") {
 			v.err = sqlerr.FunctionNotFound("sqlc." + fn.Name)
 			return nil
 		}
 
+		if fn.Name == "orderby" {
+			v.err = validateOrderBy(call)
+			return nil
+		}
+
 		if len(call.Args.Items) != 1 {
 			v.err = &sqlerr.Error{
 				Message:  fmt.Sprintf("expected 1 parameter to sqlc.%s; got %d", fn.Name, len(call.Args.Items)),
@@ -61,6 +67,29 @@ func (v *sqlcFuncVisitor) Visit(node ast.Node) astutils.Visitor {
 	return nil
 }
 
+// validateOrderBy checks that sqlc.orderby is given a parameter name and at
+// least one sort key
+func validateOrderBy(call *ast.FuncCall) error {
+	if len(call.Args.Items) < 2 {
+		return &sqlerr.Error{
+			Message:  fmt.Sprintf("expected at least 2 parameters to sqlc.orderby; got %d", len(call.Args.Items)),
+			Location: call.Pos(),
+		}
+	}
+	for _, arg := range call.Args.Items {
+		switch n := arg.(type) {
+		case *ast.A_Const:
+		case *ast.ColumnRef:
+		default:
+			return &sqlerr.Error{
+				Message:  fmt.Sprintf("expected parameters to sqlc.orderby to be strings or references; got %T", n),
+				Location: call.Pos(),
+			}
+		}
+	}
+	return nil
+}
+
 func SqlcFunctions(n ast.Node) error {
 	visitor := sqlcFuncVisitor{}
 	astutils.Walk(&visitor, n)
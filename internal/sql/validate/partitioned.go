@@ -0,0 +1,105 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+type aggregateVisitor struct {
+	isAggregate func(*ast.FuncCall) bool
+	call        *ast.FuncCall
+}
+
+func (v *aggregateVisitor) Visit(node ast.Node) astutils.Visitor {
+	if v.call != nil {
+		return nil
+	}
+	switch n := node.(type) {
+	case *ast.SubLink:
+		// Scalar subqueries are evaluated for each row
+		return nil
+	case *ast.FuncCall:
+		if n.Over == nil && v.isAggregate(n) {
+			v.call = n
+			return nil
+		}
+	}
+	return v
+}
+
+func notPartitionable(what string, location int) error {
+	return &sqlerr.Error{
+		Message:  fmt.Sprintf(":partitioned queries must be root-partitionable and can't have %s", what),
+		Location: location,
+	}
+}
+
+// Partitioned checks a :partitioned query. Spanner's PartitionQuery only
+// accepts root-partitionable queries, whose execution plan starts with a
+// distributed union: each partition returns its rows independently, so the
+// query can't sort, limit, deduplicate or aggregate the rows of all the
+// partitions.
+//
+// https://cloud.google.com/spanner/docs/reads#read_data_in_parallel
+func Partitioned(n ast.Node, isAggregate func(*ast.FuncCall) bool) error {
+	stmt, ok := n.(*ast.SelectStmt)
+	if !ok {
+		return errors.New(":partitioned requires a SELECT statement")
+	}
+	// Partitions are read in a batch read-only transaction, which can't lock
+	locks := astutils.Search(n, func(node ast.Node) bool {
+		_, ok := node.(*ast.LockingClause)
+		return ok
+	})
+	if len(locks.Items) > 0 {
+		return &sqlerr.Error{
+			Message:  ":partitioned queries run in a read-only transaction and can't use FOR UPDATE or LOCK_SCANNED_RANGES=exclusive",
+			Location: locks.Items[0].Pos(),
+		}
+	}
+	if stmt.SortClause != nil && len(stmt.SortClause.Items) > 0 {
+		return notPartitionable("an ORDER BY clause", stmt.SortClause.Items[0].Pos())
+	}
+	if stmt.LimitCount != nil {
+		return notPartitionable("a LIMIT clause", stmt.LimitCount.Pos())
+	}
+	return partitionedSelect(stmt, isAggregate)
+}
+
+func partitionedSelect(stmt *ast.SelectStmt, isAggregate func(*ast.FuncCall) bool) error {
+	if stmt.Larg != nil || stmt.Rarg != nil {
+		for _, arg := range []*ast.SelectStmt{stmt.Larg, stmt.Rarg} {
+			if arg == nil {
+				continue
+			}
+			if err := partitionedSelect(arg, isAggregate); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if stmt.GroupClause != nil && len(stmt.GroupClause.Items) > 0 {
+		return notPartitionable("a GROUP BY clause", stmt.GroupClause.Items[0].Pos())
+	}
+	if stmt.TargetList == nil {
+		return nil
+	}
+	if stmt.DistinctClause != nil && len(stmt.TargetList.Items) > 0 {
+		first := stmt.TargetList.Items[0]
+		if res, ok := first.(*ast.ResTarget); ok && res.Val != nil {
+			first = res.Val
+		}
+		return notPartitionable("SELECT DISTINCT", first.Pos())
+	}
+	v := &aggregateVisitor{isAggregate: isAggregate}
+	astutils.Walk(v, stmt.TargetList)
+	if v.call != nil {
+		return notPartitionable(fmt.Sprintf("aggregate functions such as %s", strings.ToUpper(v.call.Func.Name)), v.call.Pos())
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package sqltest
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/spanner"
+	"cloud.google.com/go/spanner/apiv1/spannerpb"
+	"cloud.google.com/go/spanner/spannertest"
+	"cloud.google.com/go/spanner/spansql"
+	spannerdriver "github.com/googleapis/go-sql-spanner"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+// Spanner starts an in-memory Spanner server, so unlike the other helpers it
+// needs neither an emulator nor a database server. The fake only implements a
+// subset of GoogleSQL, see the documentation of spannertest for what is
+// missing.
+func Spanner(t *testing.T, migrations []string) (*sql.DB, func()) {
+	t.Helper()
+
+	// For each test, pick a new database name at random.
+	name := "sqltest_spanner_" + strings.ToLower(id())
+	return CreateSpannerDatabase(t, name, migrations)
+}
+
+func CreateSpannerDatabase(t *testing.T, name string, migrations []string) (*sql.DB, func()) {
+	t.Helper()
+
+	srv, err := spannertest.NewServer("localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := sqlpath.Glob(migrations)
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		blob, err := os.ReadFile(f)
+		if err != nil {
+			srv.Close()
+			t.Fatal(err)
+		}
+		ddl, err := spansql.ParseDDL(filepath.Base(f), string(blob))
+		if err != nil {
+			srv.Close()
+			t.Fatalf("%s: %s", filepath.Base(f), err)
+		}
+		if err := srv.UpdateDDL(ddl); err != nil {
+			srv.Close()
+			t.Fatalf("%s: %s", filepath.Base(f), err)
+		}
+	}
+
+	t.Logf("db: %s/projects/sqltest/instances/sqltest/databases/%s", srv.Addr, name)
+	connector, err := spannerdriver.CreateConnector(spannerdriver.ConnectorConfig{
+		Host:     srv.Addr,
+		Project:  "sqltest",
+		Instance: "sqltest",
+		Database: name,
+		Configurator: func(_ *spanner.ClientConfig, opts *[]option.ClientOption) {
+			*opts = append(*opts,
+				option.WithoutAuthentication(),
+				option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+				option.WithGRPCDialOption(grpc.WithStreamInterceptor(spannertestStream)),
+			)
+		},
+	})
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	sdb := sql.OpenDB(connector)
+
+	return sdb, func() {
+		sdb.Close()
+		srv.Close()
+	}
+}
+
+// spannertestStream adapts the streaming calls of go-sql-spanner to what
+// spannertest implements. The driver detects the database dialect with a query
+// on INFORMATION_SCHEMA.DATABASE_OPTIONS, which the fake doesn't have, and runs
+// DML with ExecuteStreamingSql, which the fake only accepts as ExecuteSql.
+func spannertestStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if method != "/google.spanner.v1.Spanner/ExecuteStreamingSql" {
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+	return &executeStream{ctx: ctx, open: func() (grpc.ClientStream, error) {
+		return streamer(ctx, desc, cc, method, opts...)
+	}, invoke: func(req, resp any) error {
+		return cc.Invoke(ctx, "/google.spanner.v1.Spanner/ExecuteSql", req, resp, opts...)
+	}}, nil
+}
+
+// dialectProbe is the query go-sql-spanner runs to detect the dialect of the
+// database.
+const dialectProbe = "select option_value from information_schema.database_options where option_name='database_dialect'"
+
+// executeStream delays opening the stream until the request is known, so DML
+// can be sent as a unary ExecuteSql call instead.
+type executeStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	open   func() (grpc.ClientStream, error)
+	invoke func(req, resp any) error
+
+	// result holds the response of a DML statement until it is received.
+	result *spannerpb.PartialResultSet
+	done   bool
+}
+
+func (s *executeStream) SendMsg(m any) error {
+	req := m.(*spannerpb.ExecuteSqlRequest)
+	if req.Sql == dialectProbe {
+		req.Sql = "SELECT 'GOOGLE_STANDARD_SQL' AS option_value"
+	}
+	// The parser of spannertest doesn't accept a trailing semicolon.
+	req.Sql = strings.TrimSuffix(strings.TrimSpace(req.Sql), ";")
+	if isDML(req.Sql) {
+		var rs spannerpb.ResultSet
+		if err := s.invoke(req, &rs); err != nil {
+			return err
+		}
+		// Rows are only returned by DML with a THEN RETURN clause.
+		s.result = &spannerpb.PartialResultSet{Metadata: rs.Metadata, Stats: rs.Stats}
+		for _, row := range rs.Rows {
+			s.result.Values = append(s.result.Values, row.Values...)
+		}
+		if s.result.Metadata == nil {
+			s.result.Metadata = &spannerpb.ResultSetMetadata{}
+		}
+		if s.result.Metadata.RowType == nil {
+			s.result.Metadata.RowType = &spannerpb.StructType{}
+		}
+		return nil
+	}
+	stream, err := s.open()
+	if err != nil {
+		return err
+	}
+	s.ClientStream = stream
+	return stream.SendMsg(m)
+}
+
+func (s *executeStream) RecvMsg(m any) error {
+	if s.ClientStream != nil {
+		return s.ClientStream.RecvMsg(m)
+	}
+	if s.done {
+		return io.EOF
+	}
+	s.done = true
+	proto.Merge(m.(*spannerpb.PartialResultSet), s.result)
+	return nil
+}
+
+func (s *executeStream) Header() (metadata.MD, error) {
+	if s.ClientStream != nil {
+		return s.ClientStream.Header()
+	}
+	return metadata.MD{}, nil
+}
+
+func (s *executeStream) Trailer() metadata.MD {
+	if s.ClientStream != nil {
+		return s.ClientStream.Trailer()
+	}
+	return metadata.MD{}
+}
+
+func (s *executeStream) CloseSend() error {
+	if s.ClientStream != nil {
+		return s.ClientStream.CloseSend()
+	}
+	return nil
+}
+
+func (s *executeStream) Context() context.Context {
+	if s.ClientStream != nil {
+		return s.ClientStream.Context()
+	}
+	return s.ctx
+}
+
+func isDML(sql string) bool {
+	for _, line := range strings.Split(sql, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "--") {
+			continue
+		}
+		switch strings.ToUpper(fields[0]) {
+		case "INSERT", "UPDATE", "DELETE":
+			return true
+		}
+		return false
+	}
+	return false
+}
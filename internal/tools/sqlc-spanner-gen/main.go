@@ -0,0 +1,267 @@
+// sqlc-spanner-gen generates the Spanner function catalog in
+// internal/engine/spanner/stdlib.go from internal/engine/spanner/functions.json.
+//
+// functions.json lists the GoogleSQL functions documented at
+// https://cloud.google.com/spanner/docs/reference/standard-sql/functions-all
+// with their overloads. Overloads are resolved by argument count in the order
+// they are listed, so new overloads should be added after the existing ones.
+//
+// Run it from the root of the repository:
+//
+//	make sqlc-spanner-gen && ~/bin/sqlc-spanner-gen
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const catalogTmpl = `
+// Code generated by sqlc-spanner-gen. DO NOT EDIT.
+
+package spanner
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+var funcsStdlib = []*catalog.Function{
+	{{- range .Categories}}
+	// {{.Name}} functions
+	{{- range .Funcs}}
+	{
+		Name: "{{.Name}}",
+		Args: []*catalog.Argument{
+			{{- range .Args}}
+			{
+				{{- if .Name}}
+				Name: "{{.Name}}",
+				{{- end}}
+				Type: &ast.TypeName{Name: "{{.Type}}"},
+				{{- if .Optional}}
+				HasDefault: true,
+				{{- end}}
+				{{- if .Variadic}}
+				Mode: ast.FuncParamVariadic,
+				{{- end}}
+			},
+			{{- end}}
+		},
+		ReturnType: &ast.TypeName{Name: "{{.Returns}}"},
+		{{- if .Nullable}}
+		ReturnTypeNullable: true,
+		{{- end}}
+	},
+	{{- end}}
+	{{- end}}
+}
+
+func isAggregateFunction(name string) bool {
+	switch name {
+	case {{range $i, $name := .Aggregates}}{{if $i}},
+		{{end}}"{{$name}}"{{end}}:
+		return true
+	}
+	return false
+}
+`
+
+// The types understood by the Spanner engine. "any" stands for a value of any
+// type and "array" for an array of them. A result of type "any" or "array"
+// has the supertype of the "any" arguments and of the elements of the "array"
+// arguments of the call, which the compiler computes from their types.
+// Results can also be an array of a known type, such as "array<string>".
+var knownTypes = map[string]struct{}{
+	"any":       {},
+	"array":     {},
+	"bool":      {},
+	"bytes":     {},
+	"date":      {},
+	"float32":   {},
+	"float64":   {},
+	"int64":     {},
+	"interval":  {},
+	"json":      {},
+	"numeric":   {},
+	"string":    {},
+	"timestamp": {},
+}
+
+// Aggregate functions return NULL when there are no rows to aggregate, or
+// only NULL ones, except for those that count rows
+var notNullAggregates = map[string]struct{}{
+	"COUNT":    {},
+	"COUNTIF":  {},
+	"COUNT_IF": {},
+}
+
+func isKnownResult(typ string) bool {
+	if elem, ok := strings.CutPrefix(typ, "array<"); ok {
+		elem, ok = strings.CutSuffix(elem, ">")
+		if !ok || elem == "any" || elem == "array" {
+			return false
+		}
+		typ = elem
+	}
+	_, ok := knownTypes[typ]
+	return ok
+}
+
+type Catalog struct {
+	Functions []Function `json:"functions"`
+}
+
+type Function struct {
+	Name       string      `json:"name"`
+	Category   string      `json:"category"`
+	Aggregate  bool        `json:"aggregate"`
+	Signatures []Signature `json:"signatures"`
+}
+
+type Signature struct {
+	Args     []Argument `json:"args"`
+	Returns  string     `json:"returns"`
+	Nullable bool       `json:"nullable"`
+}
+
+type Argument struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Optional bool   `json:"optional"`
+	Variadic bool   `json:"variadic"`
+}
+
+// Func is a single overload, as it is added to the catalog
+type Func struct {
+	Name string
+	Signature
+}
+
+type Category struct {
+	Name  string
+	Funcs []Func
+}
+
+type tmplCtx struct {
+	Categories []Category
+	Aggregates []string
+}
+
+func main() {
+	src := flag.String("src", "internal/engine/spanner/functions.json", "path to the function list")
+	dest := flag.String("dest", "internal/engine/spanner/stdlib.go", "path to the generated catalog")
+	flag.Parse()
+	if err := run(*src, *dest); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(src, dest string) error {
+	blob, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	var cat Catalog
+	dec := json.NewDecoder(bytes.NewReader(blob))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cat); err != nil {
+		return fmt.Errorf("%s: %w", src, err)
+	}
+
+	var ctx tmplCtx
+	seen := map[string]struct{}{}
+	for _, fn := range cat.Functions {
+		if err := validate(fn); err != nil {
+			return fmt.Errorf("%s: %w", src, err)
+		}
+		if _, ok := seen[fn.Name]; ok {
+			return fmt.Errorf("%s: function %s is listed more than once", src, fn.Name)
+		}
+		seen[fn.Name] = struct{}{}
+
+		if n := len(ctx.Categories); n == 0 || ctx.Categories[n-1].Name != fn.Category {
+			ctx.Categories = append(ctx.Categories, Category{Name: fn.Category})
+		}
+		c := &ctx.Categories[len(ctx.Categories)-1]
+		for _, sig := range fn.Signatures {
+			c.Funcs = append(c.Funcs, Func{Name: fn.Name, Signature: sig})
+		}
+		if fn.Aggregate {
+			ctx.Aggregates = append(ctx.Aggregates, fn.Name)
+		}
+	}
+	sort.Strings(ctx.Aggregates)
+
+	tmpl, err := template.New("").Parse(catalogTmpl)
+	if err != nil {
+		return err
+	}
+	return writeFormattedGo(tmpl, ctx, dest)
+}
+
+func validate(fn Function) error {
+	if fn.Name == "" {
+		return fmt.Errorf("function without a name")
+	}
+	if fn.Category == "" {
+		return fmt.Errorf("function %s has no category", fn.Name)
+	}
+	if len(fn.Signatures) == 0 {
+		return fmt.Errorf("function %s has no signatures", fn.Name)
+	}
+	_, notNull := notNullAggregates[fn.Name]
+	for _, sig := range fn.Signatures {
+		if !isKnownResult(sig.Returns) {
+			return fmt.Errorf("function %s returns unknown type %q", fn.Name, sig.Returns)
+		}
+		if fn.Aggregate && !notNull && !sig.Nullable {
+			return fmt.Errorf("aggregate function %s must have a nullable result", fn.Name)
+		}
+		optional := false
+		for i, arg := range sig.Args {
+			if _, ok := knownTypes[arg.Type]; !ok {
+				return fmt.Errorf("function %s has an argument of unknown type %q", fn.Name, arg.Type)
+			}
+			if arg.Variadic && i != len(sig.Args)-1 {
+				return fmt.Errorf("function %s has a variadic argument that isn't the last one", fn.Name)
+			}
+			if arg.Variadic && arg.Optional {
+				return fmt.Errorf("function %s has a variadic argument marked optional", fn.Name)
+			}
+			if optional && !arg.Optional && !arg.Variadic {
+				return fmt.Errorf("function %s has a required argument after an optional one", fn.Name)
+			}
+			optional = optional || arg.Optional
+		}
+	}
+	return nil
+}
+
+// writeFormattedGo executes `tmpl` with `data` as its context to the file `destPath`
+func writeFormattedGo(tmpl *template.Template, data any, destPath string) error {
+	out := bytes.NewBuffer([]byte{})
+	err := tmpl.Execute(out, data)
+	if err != nil {
+		return err
+	}
+	code, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(destPath, code, 0644)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}